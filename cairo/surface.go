@@ -290,6 +290,16 @@ func (v *Surface) GetWidth() int {
 	return int(C.cairo_image_surface_get_width(v.surface))
 }
 
+// GetStride is a wrapper around cairo_image_surface_get_stride().
+func (v *Surface) GetStride() int {
+	return int(C.cairo_image_surface_get_stride(v.surface))
+}
+
+// GetFormat is a wrapper around cairo_image_surface_get_format().
+func (v *Surface) GetFormat() Format {
+	return Format(C.cairo_image_surface_get_format(v.surface))
+}
+
 // GetData is a wrapper around cairo_image_surface_get_data().
 func (v *Surface) GetData() unsafe.Pointer {
 	return unsafe.Pointer(C.cairo_image_surface_get_data(v.surface))
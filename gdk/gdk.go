@@ -33,6 +33,7 @@ func init() {
 	tm := []glib.TypeMarshaler{
 		// Enums
 		{glib.Type(C.gdk_drag_action_get_type()), marshalDragAction},
+		{glib.Type(C.gdk_drag_protocol_get_type()), marshalDragProtocol},
 		{glib.Type(C.gdk_colorspace_get_type()), marshalColorspace},
 		{glib.Type(C.gdk_event_type_get_type()), marshalEventType},
 		{glib.Type(C.gdk_interp_type_get_type()), marshalInterpType},
@@ -97,10 +98,28 @@ const (
 )
 
 func marshalDragAction(p uintptr) (interface{}, error) {
-	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
 	return DragAction(c), nil
 }
 
+// DragProtocol is a representation of GDK's GdkDragProtocol.
+type DragProtocol int
+
+const (
+	DRAG_PROTO_NONE            DragProtocol = C.GDK_DRAG_PROTO_NONE
+	DRAG_PROTO_MOTIF           DragProtocol = C.GDK_DRAG_PROTO_MOTIF
+	DRAG_PROTO_XDND            DragProtocol = C.GDK_DRAG_PROTO_XDND
+	DRAG_PROTO_ROOTWIN         DragProtocol = C.GDK_DRAG_PROTO_ROOTWIN
+	DRAG_PROTO_WIN32_DROPFILES DragProtocol = C.GDK_DRAG_PROTO_WIN32_DROPFILES
+	DRAG_PROTO_OLE2            DragProtocol = C.GDK_DRAG_PROTO_OLE2
+	DRAG_PROTO_LOCAL           DragProtocol = C.GDK_DRAG_PROTO_LOCAL
+)
+
+func marshalDragProtocol(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return DragProtocol(c), nil
+}
+
 // Colorspace is a representation of GDK's GdkColorspace.
 type Colorspace int
 
@@ -153,7 +172,7 @@ const (
 )
 
 func marshalModifierType(p uintptr) (interface{}, error) {
-	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
 	return ModifierType(c), nil
 }
 
@@ -1139,6 +1158,72 @@ func (v *DragContext) ListTargets() *glib.List {
 	return glib.WrapList(uintptr(unsafe.Pointer(clist)))
 }
 
+// ListTargetAtoms is like ListTargets, but returns the targets offered by
+// the source as a slice of Atoms.
+func (v *DragContext) ListTargetAtoms() []Atom {
+	var atoms []Atom
+	for l := C.gdk_drag_context_list_targets(v.native()); l != nil; l = l.next {
+		atoms = append(atoms, Atom(uintptr(l.data)))
+	}
+	return atoms
+}
+
+// GetActions is a wrapper around gdk_drag_context_get_actions().
+func (v *DragContext) GetActions() DragAction {
+	return DragAction(C.gdk_drag_context_get_actions(v.native()))
+}
+
+// GetSuggestedAction is a wrapper around gdk_drag_context_get_suggested_action().
+func (v *DragContext) GetSuggestedAction() DragAction {
+	return DragAction(C.gdk_drag_context_get_suggested_action(v.native()))
+}
+
+// GetSelectedAction is a wrapper around gdk_drag_context_get_selected_action().
+func (v *DragContext) GetSelectedAction() DragAction {
+	return DragAction(C.gdk_drag_context_get_selected_action(v.native()))
+}
+
+// GetSourceWindow is a wrapper around gdk_drag_context_get_source_window().
+func (v *DragContext) GetSourceWindow() (*Window, error) {
+	return toWindow(C.gdk_drag_context_get_source_window(v.native()))
+}
+
+// GetDestWindow is a wrapper around gdk_drag_context_get_dest_window().
+func (v *DragContext) GetDestWindow() (*Window, error) {
+	return toWindow(C.gdk_drag_context_get_dest_window(v.native()))
+}
+
+// GetProtocol is a wrapper around gdk_drag_context_get_protocol().
+func (v *DragContext) GetProtocol() DragProtocol {
+	return DragProtocol(C.gdk_drag_context_get_protocol(v.native()))
+}
+
+// GetDevice is a wrapper around gdk_drag_context_get_device().
+func (v *DragContext) GetDevice() (*Device, error) {
+	c := C.gdk_drag_context_get_device(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return toDevice(c)
+}
+
+// SetDevice is a wrapper around gdk_drag_context_set_device().
+func (v *DragContext) SetDevice(device *Device) {
+	C.gdk_drag_context_set_device(v.native(), device.native())
+}
+
+// DragStatus is a wrapper around gdk_drag_status(). Drop targets call it
+// from their "drag-motion" handler to tell the source which action a drop
+// would perform; an action of 0 refuses the drop.
+func (v *DragContext) DragStatus(action DragAction, time uint32) {
+	C.gdk_drag_status(v.native(), C.GdkDragAction(action), C.guint32(time))
+}
+
+// DragDropSucceeded is a wrapper around gdk_drag_drop_succeeded().
+func (v *DragContext) DragDropSucceeded() bool {
+	return gobool(C.gdk_drag_drop_succeeded(v.native()))
+}
+
 /*
  * GdkEvent
  */
@@ -1166,10 +1251,123 @@ func marshalEvent(p uintptr) (interface{}, error) {
 	return &Event{(*C.GdkEvent)(unsafe.Pointer(c))}, nil
 }
 
+// WrapEvent wraps a pointer to a GdkEvent owned by someone else, returning
+// nil for a NULL pointer. The event is not freed by the wrapper.
+func WrapEvent(p uintptr) *Event {
+	if p == 0 {
+		return nil
+	}
+	return &Event{(*C.GdkEvent)(unsafe.Pointer(p))}
+}
+
 func (v *Event) free() {
 	C.gdk_event_free(v.native())
 }
 
+// EventNew is a wrapper around gdk_event_new(). Unlike the zeroed events
+// returned by EventButtonNew and friends, the event is allocated by GDK, so a
+// device, window and sequence can be attached to it and it can be handed to
+// functions expecting a real event, such as gtk.EventController.HandleEvent.
+// Use the *NewFromEvent functions to access the type-specific fields. The
+// event is freed when it is no longer referenced.
+func EventNew(eventType EventType) *Event {
+	c := C.gdk_event_new(C.GdkEventType(eventType))
+	e := &Event{c}
+	runtime.SetFinalizer(e, (*Event).free)
+	return e
+}
+
+// GetWindow returns the window the event was sent to.
+func (v *Event) GetWindow() (*Window, error) {
+	return toWindow(C._gdk_event_get_window(v.native()))
+}
+
+// SetWindow sets the window the event is sent to. The event holds a
+// reference to window, which is released when the event is freed.
+func (v *Event) SetWindow(window *Window) {
+	C._gdk_event_set_window(v.native(), window.native())
+}
+
+// GetDevice is a wrapper around gdk_event_get_device().
+func (v *Event) GetDevice() (*Device, error) {
+	c := C.gdk_event_get_device(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return toDevice(c)
+}
+
+// SetDevice is a wrapper around gdk_event_set_device().
+func (v *Event) SetDevice(device *Device) {
+	C.gdk_event_set_device(v.native(), device.native())
+}
+
+// GetSourceDevice is a wrapper around gdk_event_get_source_device().
+func (v *Event) GetSourceDevice() (*Device, error) {
+	c := C.gdk_event_get_source_device(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return toDevice(c)
+}
+
+// SetSourceDevice is a wrapper around gdk_event_set_source_device().
+func (v *Event) SetSourceDevice(device *Device) {
+	C.gdk_event_set_source_device(v.native(), device.native())
+}
+
+// GetCoords is a wrapper around gdk_event_get_coords(). ok is false if the
+// event does not carry coordinates.
+func (v *Event) GetCoords() (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = gobool(C.gdk_event_get_coords(v.native(), &cx, &cy))
+	return float64(cx), float64(cy), ok
+}
+
+// GetEventSequence is a wrapper around gdk_event_get_event_sequence(). It
+// returns nil for events that are not part of a touch sequence, such as
+// pointer events.
+func (v *Event) GetEventSequence() *EventSequence {
+	return wrapEventSequence(C.gdk_event_get_event_sequence(v.native()))
+}
+
+/*
+ * GdkEventSequence
+ */
+
+// EventSequence is a representation of GDK's GdkEventSequence, an opaque
+// identifier of a touch sequence. A nil *EventSequence stands for the NULL
+// sequence of pointer events.
+type EventSequence struct {
+	seq *C.GdkEventSequence
+}
+
+// native returns a pointer to the underlying GdkEventSequence.
+func (v *EventSequence) native() *C.GdkEventSequence {
+	if v == nil {
+		return nil
+	}
+	return v.seq
+}
+
+// Native returns a pointer to the underlying GdkEventSequence.
+func (v *EventSequence) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// WrapEventSequence wraps a pointer to a GdkEventSequence, returning nil
+// for a NULL pointer.
+func WrapEventSequence(p uintptr) *EventSequence {
+	return wrapEventSequence((*C.GdkEventSequence)(unsafe.Pointer(p)))
+}
+
+func wrapEventSequence(seq *C.GdkEventSequence) *EventSequence {
+	if seq == nil {
+		return nil
+	}
+	return &EventSequence{seq}
+}
+
 /*
  * GdkEventButton
  */
@@ -1193,9 +1391,7 @@ func EventButtonNew() *EventButton {
 // *EventButton. EventButtonNewFromEvent provides a means of creating
 // an EventKey from the Event.
 func EventButtonNewFromEvent(event *Event) *EventButton {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventButton{&ev}
+	return &EventButton{event}
 }
 
 // Native returns a pointer to the underlying GdkEventButton.
@@ -1262,6 +1458,27 @@ func (v *EventButton) MotionValRoot() (float64, float64) {
 	return float64(x), float64(y)
 }
 
+// SetMotionVal sets the x and y fields of the event, relative to its window.
+func (v *EventButton) SetMotionVal(x, y float64) {
+	v.native().x = C.gdouble(x)
+	v.native().y = C.gdouble(y)
+}
+
+// SetButton sets the button field of the event.
+func (v *EventButton) SetButton(button Button) {
+	v.native().button = C.guint(button)
+}
+
+// SetState sets the state field of the event.
+func (v *EventButton) SetState(state ModifierType) {
+	v.native().state = C.guint(state)
+}
+
+// SetTime sets the time of the event in milliseconds.
+func (v *EventButton) SetTime(time uint32) {
+	v.native().time = C.guint32(time)
+}
+
 /*
  * GdkEventKey
  */
@@ -1285,9 +1502,7 @@ func EventKeyNew() *EventKey {
 // *EventKey. EventKeyNewFromEvent provides a means of creating
 // an EventKey from the Event.
 func EventKeyNewFromEvent(event *Event) *EventKey {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventKey{&ev}
+	return &EventKey{event}
 }
 
 // Native returns a pointer to the underlying GdkEventKey.
@@ -1319,6 +1534,21 @@ func (v *EventKey) State() uint {
 	return uint(c)
 }
 
+// SetKeyVal sets the keyval field of the event.
+func (v *EventKey) SetKeyVal(keyval uint) {
+	v.native().keyval = C.guint(keyval)
+}
+
+// SetHardwareKeyCode sets the hardware_keycode field of the event.
+func (v *EventKey) SetHardwareKeyCode(keycode uint16) {
+	v.native().hardware_keycode = C.guint16(keycode)
+}
+
+// SetState sets the state field of the event.
+func (v *EventKey) SetState(state ModifierType) {
+	v.native().state = C.guint(state)
+}
+
 /*
  * GdkEventMotion
  */
@@ -1341,9 +1571,7 @@ func EventMotionNew() *EventMotion {
 // *EventMotion. EventMotionNewFromEvent provides a means of creating
 // an EventKey from the Event.
 func EventMotionNewFromEvent(event *Event) *EventMotion {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventMotion{&ev}
+	return &EventMotion{event}
 }
 
 // Native returns a pointer to the underlying GdkEventMotion.
@@ -1385,6 +1613,22 @@ func (v *EventMotion) State() ModifierType {
 	return ModifierType(c)
 }
 
+// SetMotionVal sets the x and y fields of the event, relative to its window.
+func (v *EventMotion) SetMotionVal(x, y float64) {
+	v.native().x = C.gdouble(x)
+	v.native().y = C.gdouble(y)
+}
+
+// SetState sets the state field of the event.
+func (v *EventMotion) SetState(state ModifierType) {
+	v.native().state = C.guint(state)
+}
+
+// SetTime sets the time of the event in milliseconds.
+func (v *EventMotion) SetTime(time uint32) {
+	v.native().time = C.guint32(time)
+}
+
 /*
  * GdkEventCrossing
  */
@@ -1408,9 +1652,7 @@ func EventCrossingNew() *EventCrossing {
 // *EventCrossing. EventCrossingNewFromEvent provides a means of creating
 // an EventCrossing from the Event.
 func EventCrossingNewFromEvent(event *Event) *EventCrossing {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventCrossing{&ev}
+	return &EventCrossing{event}
 }
 
 // Native returns a pointer to the underlying GdkEventCrossing.
@@ -1487,6 +1729,17 @@ func (v *EventCrossing) Focus() bool {
 	return gobool(c)
 }
 
+// SetMotionVal sets the x and y fields of the event, relative to its window.
+func (v *EventCrossing) SetMotionVal(x, y float64) {
+	v.native().x = C.gdouble(x)
+	v.native().y = C.gdouble(y)
+}
+
+// SetTime sets the time of the event in milliseconds.
+func (v *EventCrossing) SetTime(time uint32) {
+	v.native().time = C.guint32(time)
+}
+
 /*
  * GdkEventScroll
  */
@@ -1510,9 +1763,7 @@ func EventScrollNew() *EventScroll {
 // *EventScroll. EventScrollNewFromEvent provides a means of creating
 // an EventKey from the Event.
 func EventScrollNewFromEvent(event *Event) *EventScroll {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventScroll{&ev}
+	return &EventScroll{event}
 }
 
 // Native returns a pointer to the underlying GdkEventScroll.
@@ -1557,6 +1808,108 @@ func (v *EventScroll) State() ModifierType {
 	return ModifierType(c)
 }
 
+// SetMotionVal sets the x and y fields of the event, relative to its window.
+func (v *EventScroll) SetMotionVal(x, y float64) {
+	v.native().x = C.gdouble(x)
+	v.native().y = C.gdouble(y)
+}
+
+// SetDirection sets the direction field of the event. The deltas are only
+// used with SCROLL_SMOOTH.
+func (v *EventScroll) SetDirection(direction ScrollDirection) {
+	v.native().direction = C.GdkScrollDirection(direction)
+}
+
+// SetDeltas sets the delta_x and delta_y fields of a SCROLL_SMOOTH event.
+func (v *EventScroll) SetDeltas(dx, dy float64) {
+	v.native().delta_x = C.gdouble(dx)
+	v.native().delta_y = C.gdouble(dy)
+}
+
+// SetTime sets the time of the event in milliseconds.
+func (v *EventScroll) SetTime(time uint32) {
+	v.native().time = C.guint32(time)
+}
+
+/*
+ * GdkEventTouch
+ */
+
+// EventTouch is a representation of GDK's GdkEventTouch.
+type EventTouch struct {
+	*Event
+}
+
+// EventTouchNewFromEvent returns an EventTouch from an Event.
+//
+// Using widget.Connect() for the "touch-event" signal results in a *Event
+// being passed as the callback's second argument. The argument is actually
+// a *EventTouch. EventTouchNewFromEvent provides a means of creating an
+// EventTouch from the Event.
+func EventTouchNewFromEvent(event *Event) *EventTouch {
+	return &EventTouch{event}
+}
+
+// Native returns a pointer to the underlying GdkEventTouch.
+func (v *EventTouch) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventTouch) native() *C.GdkEventTouch {
+	return (*C.GdkEventTouch)(unsafe.Pointer(v.Event.native()))
+}
+
+func (v *EventTouch) Type() EventType {
+	c := v.native()._type
+	return EventType(c)
+}
+
+func (v *EventTouch) MotionVal() (float64, float64) {
+	x := v.native().x
+	y := v.native().y
+	return float64(x), float64(y)
+}
+
+func (v *EventTouch) Time() uint32 {
+	c := v.native().time
+	return uint32(c)
+}
+
+// EmulatingPointer returns whether the event is the one of the touch
+// sequence emulating pointer events.
+func (v *EventTouch) EmulatingPointer() bool {
+	return gobool(v.native().emulating_pointer)
+}
+
+// SetMotionVal sets the x and y fields of the event, relative to its window.
+func (v *EventTouch) SetMotionVal(x, y float64) {
+	v.native().x = C.gdouble(x)
+	v.native().y = C.gdouble(y)
+}
+
+// SetTime sets the time of the event in milliseconds.
+func (v *EventTouch) SetTime(time uint32) {
+	v.native().time = C.guint32(time)
+}
+
+// SetState sets the state field of the event.
+func (v *EventTouch) SetState(state ModifierType) {
+	v.native().state = C.guint(state)
+}
+
+// SetSequence sets the touch sequence the event belongs to. The sequences
+// are opaque identifiers which are only compared, so distinct sequences may
+// be made up with WrapEventSequence to synthesize multi-touch events.
+func (v *EventTouch) SetSequence(sequence *EventSequence) {
+	v.native().sequence = sequence.native()
+}
+
+// SetEmulatingPointer sets whether the event is the one of the touch
+// sequence emulating pointer events.
+func (v *EventTouch) SetEmulatingPointer(emulating bool) {
+	v.native().emulating_pointer = gbool(emulating)
+}
+
 /*
  * GdkEventWindowState
  */
@@ -1580,9 +1933,7 @@ func EventWindowStateNew() *EventWindowState {
 // *EventWindowState. EventWindowStateNewFromEvent provides a means of creating
 // an EventWindowState from the Event.
 func EventWindowStateNewFromEvent(event *Event) *EventWindowState {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventWindowState{&ev}
+	return &EventWindowState{event}
 }
 
 // Native returns a pointer to the underlying GdkEventWindowState.
@@ -1632,9 +1983,7 @@ func EventConfigureNew() *EventConfigure {
 // *EventConfigure. EventConfigureNewFromEvent provides a means of creating
 // an EventConfigure from the Event.
 func EventConfigureNewFromEvent(event *Event) *EventConfigure {
-	ee := (*C.GdkEvent)(unsafe.Pointer(event.native()))
-	ev := Event{ee}
-	return &EventConfigure{&ev}
+	return &EventConfigure{event}
 }
 
 // Native returns a pointer to the underlying GdkEventConfigure.
@@ -2044,7 +2393,7 @@ func (v *Window) WindowGetHeight() (height int) {
 	return int(C.gdk_window_get_height(v.native()))
 }
 
-//PixbufGetFromWindow is a wrapper around gdk_pixbuf_get_from_window()
+// PixbufGetFromWindow is a wrapper around gdk_pixbuf_get_from_window()
 func (v *Window) PixbufGetFromWindow(x, y, w, h int) (*Pixbuf, error) {
 	c := C.gdk_pixbuf_get_from_window(v.native(), C.gint(x), C.gint(y), C.gint(w), C.gint(h))
 	if c == nil {
@@ -80,3 +80,19 @@ toGdkWindow(void *p)
 }
 
 static inline gchar** next_gcharptr(gchar** s) { return (s+1); }
+
+static GdkWindow *
+_gdk_event_get_window(GdkEvent *event)
+{
+	return (event->any.window);
+}
+
+static void
+_gdk_event_set_window(GdkEvent *event, GdkWindow *window)
+{
+	if (window != NULL)
+		g_object_ref(window);
+	if (event->any.window != NULL)
+		g_object_unref(event->any.window);
+	event->any.window = window;
+}
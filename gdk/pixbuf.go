@@ -33,8 +33,23 @@ func init() {
  * Constants
  */
 
-// TODO:
-// GdkPixbufError
+// PixbufError is a representation of GDK's GdkPixbufError.
+type PixbufError int
+
+const (
+	PIXBUF_ERROR_CORRUPT_IMAGE         PixbufError = C.GDK_PIXBUF_ERROR_CORRUPT_IMAGE
+	PIXBUF_ERROR_INSUFFICIENT_MEMORY   PixbufError = C.GDK_PIXBUF_ERROR_INSUFFICIENT_MEMORY
+	PIXBUF_ERROR_BAD_OPTION            PixbufError = C.GDK_PIXBUF_ERROR_BAD_OPTION
+	PIXBUF_ERROR_UNKNOWN_TYPE          PixbufError = C.GDK_PIXBUF_ERROR_UNKNOWN_TYPE
+	PIXBUF_ERROR_UNSUPPORTED_OPERATION PixbufError = C.GDK_PIXBUF_ERROR_UNSUPPORTED_OPERATION
+	PIXBUF_ERROR_FAILED                PixbufError = C.GDK_PIXBUF_ERROR_FAILED
+)
+
+// PixbufErrorQuark is a wrapper around gdk_pixbuf_error_quark(), the
+// GDK_PIXBUF_ERROR domain.
+func PixbufErrorQuark() glib.Quark {
+	return glib.Quark(C.gdk_pixbuf_error_quark())
+}
 
 // PixbufRotation is a representation of GDK's GdkPixbufRotation.
 type PixbufRotation int
@@ -126,8 +141,7 @@ func PixbufNewFromFile(filename string) (*Pixbuf, error) {
 	var err *C.GError
 	c := C.gdk_pixbuf_new_from_file((*C.char)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
@@ -320,8 +334,7 @@ func (v *Pixbuf) SaveJPEG(path string, quality int) error {
 	var err *C.GError
 	c := C._gdk_pixbuf_save_jpeg(v.native(), cpath, &err, cquality)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -338,8 +351,7 @@ func (v *Pixbuf) SavePNG(path string, compression int) error {
 	var err *C.GError
 	c := C._gdk_pixbuf_save_png(v.native(), cpath, &err, ccompression)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -410,8 +422,7 @@ func PixbufAnimationNewFromFile(filename string) (*PixbufAnimation, error) {
 	var err *C.GError
 	c := C.gdk_pixbuf_animation_new_from_file((*C.char)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
@@ -465,8 +476,7 @@ func PixbufLoaderNewWithType(t string) (*PixbufLoader, error) {
 
 	c := C.gdk_pixbuf_loader_new_with_type((*C.char)(cstr), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if c == nil {
@@ -495,8 +505,7 @@ func (v *PixbufLoader) Write(data []byte) (int, error) {
 		&err)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return 0, errors.New(C.GoString((*C.char)(err.message)))
+		return 0, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return len(data), nil
@@ -512,8 +521,7 @@ func (v *PixbufLoader) WriteAndReturnPixbuf(data []byte) (*Pixbuf, error) {
 	c := C.gdk_pixbuf_loader_write(v.native(), (*C.guchar)(unsafe.Pointer(&data[0])), C.gsize(len(data)), &err)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	v.Close()
@@ -538,8 +546,7 @@ func (v *PixbufLoader) Close() error {
 	var err *C.GError
 
 	if ok := gobool(C.gdk_pixbuf_loader_close(v.native(), &err)); !ok {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -582,8 +589,7 @@ func (v *PixbufLoader) WriteAndReturnPixbufAnimation(data []byte) (*PixbufAnimat
 	c := C.gdk_pixbuf_loader_write(v.native(), (*C.guchar)(unsafe.Pointer(&data[0])), C.gsize(len(data)), &err)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	v.Close()
@@ -10,7 +10,6 @@ package gdk
 // #include "pixbuf.go.h"
 import "C"
 import (
-	"errors"
 	"runtime"
 	"unsafe"
 
@@ -36,8 +35,7 @@ func PixbufNewFromFileAtSize(filename string, width, height int) (*Pixbuf, error
 	var err *C.GError = nil
 	c := C.gdk_pixbuf_new_from_file_at_size(cstr, C.int(width), C.int(height), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if c == nil {
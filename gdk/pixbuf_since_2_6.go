@@ -10,7 +10,6 @@ package gdk
 // #include "pixbuf.go.h"
 import "C"
 import (
-	"errors"
 	"runtime"
 	"unsafe"
 
@@ -28,8 +27,7 @@ func PixbufNewFromFileAtScale(filename string, width, height int, preserveAspect
 	c := C.gdk_pixbuf_new_from_file_at_scale(cstr, C.int(width), C.int(height),
 		gbool(preserveAspectRatio), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if c == nil {
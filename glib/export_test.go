@@ -0,0 +1,7 @@
+package glib
+
+// Exported for the tests of package glib_test, which cannot use cgo.
+var (
+	GetLocale = getLocale
+	SetLocale = setLocale
+)
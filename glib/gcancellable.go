@@ -6,7 +6,6 @@ package glib
 // #include "glib.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 )
 
@@ -47,14 +46,18 @@ func (v *Cancellable) IsCancelled() bool {
 	return gobool(c)
 }
 
+// Cancel is a wrapper around g_cancellable_cancel().
+func (v *Cancellable) Cancel() {
+	C.g_cancellable_cancel(v.native())
+}
+
 // SetErrorIfCancelled is a wrapper around g_cancellable_set_error_if_cancelled().
 func (v *Cancellable) SetErrorIfCancelled() error {
 	var err *C.GError
 	c := C.g_cancellable_set_error_if_cancelled(v.native(), &err)
 	cancelled := gobool(c)
 	if cancelled {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
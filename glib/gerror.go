@@ -0,0 +1,217 @@
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+/*
+ * GQuark
+ */
+
+// QuarkFromString is a wrapper around g_quark_from_string().
+func QuarkFromString(s string) Quark {
+	cstr := (*C.gchar)(C.CString(s))
+	defer C.free(unsafe.Pointer(cstr))
+	return Quark(C.g_quark_from_string(cstr))
+}
+
+// String is a wrapper around g_quark_to_string().
+func (q Quark) String() string {
+	return C.GoString((*C.char)(C.g_quark_to_string(C.GQuark(q))))
+}
+
+/*
+ * GError
+ */
+
+// Error is a representation of GLib's GError. It implements the error
+// interface and keeps the domain and code of the original GError, so
+// callers can use errors.As or errors.Is to tell failures apart.
+type Error struct {
+	domain  Quark
+	code    int
+	message string
+}
+
+// NewError creates a new Error with the given domain, code and message.
+// It is mostly useful as a target for errors.Is.
+func NewError(domain Quark, code int, message string) *Error {
+	return &Error{domain: domain, code: code, message: message}
+}
+
+// Error returns the message of the GError.
+func (v *Error) Error() string {
+	return v.message
+}
+
+// Domain returns the error domain of the GError.
+func (v *Error) Domain() Quark {
+	return v.domain
+}
+
+// Code returns the error code of the GError.
+func (v *Error) Code() int {
+	return v.code
+}
+
+// Matches is a Go implementation of g_error_matches().
+func (v *Error) Matches(domain Quark, code int) bool {
+	if v == nil {
+		return false
+	}
+	return v.domain == domain && v.code == code
+}
+
+// Is reports whether target is an *Error with the same domain and code.
+// The message is ignored, so errors created with NewError can be used as
+// sentinels with errors.Is.
+func (v *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return v.Matches(t.domain, t.code)
+}
+
+// newError copies a C GError into a Go Error, or returns nil if err is
+// NULL. The GError is not freed.
+func newError(err *C.GError) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		domain:  Quark(err.domain),
+		code:    int(err.code),
+		message: C.GoString((*C.char)(err.message)),
+	}
+}
+
+// takeError copies a C GError into a Go Error and frees the GError, or
+// returns nil if err is NULL.
+func takeError(err *C.GError) error {
+	if err == nil {
+		return nil
+	}
+	defer C.g_error_free(err)
+	return newError(err)
+}
+
+// WrapError copies a GError into a Go Error without taking ownership
+// of it. It returns nil if ptr is nil.
+// This function is exported for visibility in other gotk3 packages and
+// is not meant to be used by applications.
+func WrapError(ptr unsafe.Pointer) error {
+	return newError((*C.GError)(ptr))
+}
+
+// TakeError copies a GError into a Go Error and frees the GError. It
+// returns nil if ptr is nil.
+// This function is exported for visibility in other gotk3 packages and
+// is not meant to be used by applications.
+func TakeError(ptr unsafe.Pointer) error {
+	return takeError((*C.GError)(ptr))
+}
+
+/*
+ * Common error domains
+ */
+
+// IOErrorQuark is a wrapper around g_io_error_quark(), the G_IO_ERROR
+// domain.
+func IOErrorQuark() Quark {
+	return Quark(C.g_io_error_quark())
+}
+
+// FileErrorQuark is a wrapper around g_file_error_quark(), the
+// G_FILE_ERROR domain.
+func FileErrorQuark() Quark {
+	return Quark(C.g_file_error_quark())
+}
+
+// IOErrorEnum is a representation of GIO's GIOErrorEnum.
+type IOErrorEnum int
+
+const (
+	IO_ERROR_FAILED              IOErrorEnum = C.G_IO_ERROR_FAILED
+	IO_ERROR_NOT_FOUND           IOErrorEnum = C.G_IO_ERROR_NOT_FOUND
+	IO_ERROR_EXISTS              IOErrorEnum = C.G_IO_ERROR_EXISTS
+	IO_ERROR_IS_DIRECTORY        IOErrorEnum = C.G_IO_ERROR_IS_DIRECTORY
+	IO_ERROR_NOT_DIRECTORY       IOErrorEnum = C.G_IO_ERROR_NOT_DIRECTORY
+	IO_ERROR_NOT_EMPTY           IOErrorEnum = C.G_IO_ERROR_NOT_EMPTY
+	IO_ERROR_NOT_REGULAR_FILE    IOErrorEnum = C.G_IO_ERROR_NOT_REGULAR_FILE
+	IO_ERROR_NOT_SYMBOLIC_LINK   IOErrorEnum = C.G_IO_ERROR_NOT_SYMBOLIC_LINK
+	IO_ERROR_NOT_MOUNTABLE_FILE  IOErrorEnum = C.G_IO_ERROR_NOT_MOUNTABLE_FILE
+	IO_ERROR_FILENAME_TOO_LONG   IOErrorEnum = C.G_IO_ERROR_FILENAME_TOO_LONG
+	IO_ERROR_INVALID_FILENAME    IOErrorEnum = C.G_IO_ERROR_INVALID_FILENAME
+	IO_ERROR_TOO_MANY_LINKS      IOErrorEnum = C.G_IO_ERROR_TOO_MANY_LINKS
+	IO_ERROR_NO_SPACE            IOErrorEnum = C.G_IO_ERROR_NO_SPACE
+	IO_ERROR_INVALID_ARGUMENT    IOErrorEnum = C.G_IO_ERROR_INVALID_ARGUMENT
+	IO_ERROR_PERMISSION_DENIED   IOErrorEnum = C.G_IO_ERROR_PERMISSION_DENIED
+	IO_ERROR_NOT_SUPPORTED       IOErrorEnum = C.G_IO_ERROR_NOT_SUPPORTED
+	IO_ERROR_NOT_MOUNTED         IOErrorEnum = C.G_IO_ERROR_NOT_MOUNTED
+	IO_ERROR_ALREADY_MOUNTED     IOErrorEnum = C.G_IO_ERROR_ALREADY_MOUNTED
+	IO_ERROR_CLOSED              IOErrorEnum = C.G_IO_ERROR_CLOSED
+	IO_ERROR_CANCELLED           IOErrorEnum = C.G_IO_ERROR_CANCELLED
+	IO_ERROR_PENDING             IOErrorEnum = C.G_IO_ERROR_PENDING
+	IO_ERROR_READ_ONLY           IOErrorEnum = C.G_IO_ERROR_READ_ONLY
+	IO_ERROR_CANT_CREATE_BACKUP  IOErrorEnum = C.G_IO_ERROR_CANT_CREATE_BACKUP
+	IO_ERROR_WRONG_ETAG          IOErrorEnum = C.G_IO_ERROR_WRONG_ETAG
+	IO_ERROR_TIMED_OUT           IOErrorEnum = C.G_IO_ERROR_TIMED_OUT
+	IO_ERROR_WOULD_RECURSE       IOErrorEnum = C.G_IO_ERROR_WOULD_RECURSE
+	IO_ERROR_BUSY                IOErrorEnum = C.G_IO_ERROR_BUSY
+	IO_ERROR_WOULD_BLOCK         IOErrorEnum = C.G_IO_ERROR_WOULD_BLOCK
+	IO_ERROR_HOST_NOT_FOUND      IOErrorEnum = C.G_IO_ERROR_HOST_NOT_FOUND
+	IO_ERROR_WOULD_MERGE         IOErrorEnum = C.G_IO_ERROR_WOULD_MERGE
+	IO_ERROR_FAILED_HANDLED      IOErrorEnum = C.G_IO_ERROR_FAILED_HANDLED
+	IO_ERROR_TOO_MANY_OPEN_FILES IOErrorEnum = C.G_IO_ERROR_TOO_MANY_OPEN_FILES
+	IO_ERROR_NOT_INITIALIZED     IOErrorEnum = C.G_IO_ERROR_NOT_INITIALIZED
+	IO_ERROR_ADDRESS_IN_USE      IOErrorEnum = C.G_IO_ERROR_ADDRESS_IN_USE
+	IO_ERROR_PARTIAL_INPUT       IOErrorEnum = C.G_IO_ERROR_PARTIAL_INPUT
+	IO_ERROR_INVALID_DATA        IOErrorEnum = C.G_IO_ERROR_INVALID_DATA
+	IO_ERROR_DBUS_ERROR          IOErrorEnum = C.G_IO_ERROR_DBUS_ERROR
+	IO_ERROR_HOST_UNREACHABLE    IOErrorEnum = C.G_IO_ERROR_HOST_UNREACHABLE
+	IO_ERROR_NETWORK_UNREACHABLE IOErrorEnum = C.G_IO_ERROR_NETWORK_UNREACHABLE
+	IO_ERROR_CONNECTION_REFUSED  IOErrorEnum = C.G_IO_ERROR_CONNECTION_REFUSED
+	IO_ERROR_PROXY_FAILED        IOErrorEnum = C.G_IO_ERROR_PROXY_FAILED
+	IO_ERROR_PROXY_AUTH_FAILED   IOErrorEnum = C.G_IO_ERROR_PROXY_AUTH_FAILED
+	IO_ERROR_PROXY_NEED_AUTH     IOErrorEnum = C.G_IO_ERROR_PROXY_NEED_AUTH
+	IO_ERROR_PROXY_NOT_ALLOWED   IOErrorEnum = C.G_IO_ERROR_PROXY_NOT_ALLOWED
+	IO_ERROR_BROKEN_PIPE         IOErrorEnum = C.G_IO_ERROR_BROKEN_PIPE
+	IO_ERROR_NOT_CONNECTED       IOErrorEnum = C.G_IO_ERROR_NOT_CONNECTED
+)
+
+// FileError is a representation of GLib's GFileError.
+type FileError int
+
+const (
+	FILE_ERROR_EXIST       FileError = C.G_FILE_ERROR_EXIST
+	FILE_ERROR_ISDIR       FileError = C.G_FILE_ERROR_ISDIR
+	FILE_ERROR_ACCES       FileError = C.G_FILE_ERROR_ACCES
+	FILE_ERROR_NAMETOOLONG FileError = C.G_FILE_ERROR_NAMETOOLONG
+	FILE_ERROR_NOENT       FileError = C.G_FILE_ERROR_NOENT
+	FILE_ERROR_NOTDIR      FileError = C.G_FILE_ERROR_NOTDIR
+	FILE_ERROR_NXIO        FileError = C.G_FILE_ERROR_NXIO
+	FILE_ERROR_NODEV       FileError = C.G_FILE_ERROR_NODEV
+	FILE_ERROR_ROFS        FileError = C.G_FILE_ERROR_ROFS
+	FILE_ERROR_TXTBSY      FileError = C.G_FILE_ERROR_TXTBSY
+	FILE_ERROR_FAULT       FileError = C.G_FILE_ERROR_FAULT
+	FILE_ERROR_LOOP        FileError = C.G_FILE_ERROR_LOOP
+	FILE_ERROR_NOSPC       FileError = C.G_FILE_ERROR_NOSPC
+	FILE_ERROR_NOMEM       FileError = C.G_FILE_ERROR_NOMEM
+	FILE_ERROR_MFILE       FileError = C.G_FILE_ERROR_MFILE
+	FILE_ERROR_NFILE       FileError = C.G_FILE_ERROR_NFILE
+	FILE_ERROR_BADF        FileError = C.G_FILE_ERROR_BADF
+	FILE_ERROR_INVAL       FileError = C.G_FILE_ERROR_INVAL
+	FILE_ERROR_PIPE        FileError = C.G_FILE_ERROR_PIPE
+	FILE_ERROR_AGAIN       FileError = C.G_FILE_ERROR_AGAIN
+	FILE_ERROR_INTR        FileError = C.G_FILE_ERROR_INTR
+	FILE_ERROR_IO          FileError = C.G_FILE_ERROR_IO
+	FILE_ERROR_PERM        FileError = C.G_FILE_ERROR_PERM
+	FILE_ERROR_NOSYS       FileError = C.G_FILE_ERROR_NOSYS
+	FILE_ERROR_FAILED      FileError = C.G_FILE_ERROR_FAILED
+)
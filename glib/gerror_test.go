@@ -0,0 +1,59 @@
+package glib_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestErrorFromCancellable(t *testing.T) {
+	c, err := glib.CancellableNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Cancel()
+
+	err = c.SetErrorIfCancelled()
+	if err == nil {
+		t.Fatal("expected an error from a cancelled Cancellable")
+	}
+
+	var gerr *glib.Error
+	if !errors.As(err, &gerr) {
+		t.Fatalf("expected a *glib.Error, got %T", err)
+	}
+	if gerr.Domain() != glib.IOErrorQuark() {
+		t.Errorf("expected domain %q, got %q", glib.IOErrorQuark(), gerr.Domain())
+	}
+	if !gerr.Matches(glib.IOErrorQuark(), int(glib.IO_ERROR_CANCELLED)) {
+		t.Errorf("expected code %d, got %d", glib.IO_ERROR_CANCELLED, gerr.Code())
+	}
+	if gerr.Error() == "" {
+		t.Error("expected a non-empty message")
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	err := error(glib.NewError(glib.FileErrorQuark(), int(glib.FILE_ERROR_NOENT), "no such file"))
+
+	if !errors.Is(err, glib.NewError(glib.FileErrorQuark(), int(glib.FILE_ERROR_NOENT), "")) {
+		t.Error("expected errors with the same domain and code to match")
+	}
+	if errors.Is(err, glib.NewError(glib.FileErrorQuark(), int(glib.FILE_ERROR_EXIST), "")) {
+		t.Error("expected errors with different codes not to match")
+	}
+	if errors.Is(err, glib.NewError(glib.IOErrorQuark(), int(glib.FILE_ERROR_NOENT), "")) {
+		t.Error("expected errors with different domains not to match")
+	}
+}
+
+func TestQuarkFromString(t *testing.T) {
+	q := glib.QuarkFromString("g-io-error-quark")
+	if q != glib.IOErrorQuark() {
+		t.Errorf("expected %d, got %d", glib.IOErrorQuark(), q)
+	}
+	if q.String() != "g-io-error-quark" {
+		t.Errorf("expected %q, got %q", "g-io-error-quark", q.String())
+	}
+}
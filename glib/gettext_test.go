@@ -0,0 +1,91 @@
+package glib_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// setenv sets the environment variable key to value for the duration of
+// the test.
+func setenv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// initTestLocale binds the gotk3-test domain to the German catalog compiled
+// from testdata/locale/de/LC_MESSAGES/gotk3-test.po. LANGUAGE is ignored by
+// gettext under the plain "C" locale, so C.UTF-8 is used instead. The
+// environment, the locale and the text domain are restored at the end of the
+// test.
+func initTestLocale(t *testing.T) {
+	locale := glib.GetLocale()
+	t.Cleanup(func() { glib.SetLocale(locale) })
+
+	setenv(t, "LC_ALL", "C.UTF-8")
+	setenv(t, "LANGUAGE", "de")
+	glib.InitI18n("gotk3-test", "testdata/locale")
+	// "messages" is the default domain of gettext.
+	t.Cleanup(func() { glib.Textdomain("messages") })
+
+	if glib.Local("Open") != "Öffnen" {
+		t.Skip("C.UTF-8 locale is not available")
+	}
+}
+
+func TestLocalPlural(t *testing.T) {
+	initTestLocale(t)
+
+	if s := glib.LocalPlural("%d file", "%d files", 1); s != "%d Datei" {
+		t.Errorf("expected %q, got %q", "%d Datei", s)
+	}
+	if s := glib.LocalPlural("%d file", "%d files", 3); s != "%d Dateien" {
+		t.Errorf("expected %q, got %q", "%d Dateien", s)
+	}
+}
+
+func TestLocalContext(t *testing.T) {
+	initTestLocale(t)
+
+	if s := glib.LocalContext("menu", "File"); s != "Datei" {
+		t.Errorf("expected %q, got %q", "Datei", s)
+	}
+	if s := glib.LocalContext("filesystem", "File"); s != "Akte" {
+		t.Errorf("expected %q, got %q", "Akte", s)
+	}
+	if s := glib.LocalContext("unknown", "File"); s != "File" {
+		t.Errorf("expected untranslated %q, got %q", "File", s)
+	}
+}
+
+func TestDGettext(t *testing.T) {
+	initTestLocale(t)
+	glib.Textdomain("gotk3-other")
+
+	if s := glib.Local("Open"); s != "Open" {
+		t.Errorf("expected untranslated %q in another domain, got %q", "Open", s)
+	}
+	if s := glib.DGettext("gotk3-test", "Open"); s != "Öffnen" {
+		t.Errorf("expected %q, got %q", "Öffnen", s)
+	}
+	if s := glib.DNGettext("gotk3-test", "%d file", "%d files", 2); s != "%d Dateien" {
+		t.Errorf("expected %q, got %q", "%d Dateien", s)
+	}
+	if s := glib.DPGettext2("gotk3-test", "menu", "File"); s != "Datei" {
+		t.Errorf("expected %q, got %q", "Datei", s)
+	}
+}
+
+func TestBindTextdomainCodeset(t *testing.T) {
+	if s := glib.BindTextdomainCodeset("gotk3-test", "UTF-8"); s != "UTF-8" {
+		t.Errorf("expected %q, got %q", "UTF-8", s)
+	}
+}
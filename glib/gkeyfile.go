@@ -0,0 +1,809 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <glib.h>
+// #include "glib.go.h"
+// #include "gkeyfile.go.h"
+import "C"
+import (
+	"reflect"
+	"runtime"
+	"unsafe"
+)
+
+/*
+ * GKeyFile
+ */
+
+// KeyFileFlags is a representation of GLib's GKeyFileFlags.
+type KeyFileFlags int
+
+const (
+	KEY_FILE_NONE              KeyFileFlags = C.G_KEY_FILE_NONE
+	KEY_FILE_KEEP_COMMENTS     KeyFileFlags = C.G_KEY_FILE_KEEP_COMMENTS
+	KEY_FILE_KEEP_TRANSLATIONS KeyFileFlags = C.G_KEY_FILE_KEEP_TRANSLATIONS
+)
+
+// KeyFileError is a representation of GLib's GKeyFileError.
+type KeyFileError int
+
+const (
+	KEY_FILE_ERROR_UNKNOWN_ENCODING KeyFileError = C.G_KEY_FILE_ERROR_UNKNOWN_ENCODING
+	KEY_FILE_ERROR_PARSE            KeyFileError = C.G_KEY_FILE_ERROR_PARSE
+	KEY_FILE_ERROR_NOT_FOUND        KeyFileError = C.G_KEY_FILE_ERROR_NOT_FOUND
+	KEY_FILE_ERROR_KEY_NOT_FOUND    KeyFileError = C.G_KEY_FILE_ERROR_KEY_NOT_FOUND
+	KEY_FILE_ERROR_GROUP_NOT_FOUND  KeyFileError = C.G_KEY_FILE_ERROR_GROUP_NOT_FOUND
+	KEY_FILE_ERROR_INVALID_VALUE    KeyFileError = C.G_KEY_FILE_ERROR_INVALID_VALUE
+)
+
+// KeyFileErrorQuark is a wrapper around g_key_file_error_quark(), the
+// G_KEY_FILE_ERROR domain.
+func KeyFileErrorQuark() Quark {
+	return Quark(C.g_key_file_error_quark())
+}
+
+// Group and key names defined by the Desktop Entry Specification.
+const (
+	KEY_FILE_DESKTOP_GROUP = "Desktop Entry"
+
+	KEY_FILE_DESKTOP_KEY_TYPE             = "Type"
+	KEY_FILE_DESKTOP_KEY_VERSION          = "Version"
+	KEY_FILE_DESKTOP_KEY_NAME             = "Name"
+	KEY_FILE_DESKTOP_KEY_GENERIC_NAME     = "GenericName"
+	KEY_FILE_DESKTOP_KEY_NO_DISPLAY       = "NoDisplay"
+	KEY_FILE_DESKTOP_KEY_COMMENT          = "Comment"
+	KEY_FILE_DESKTOP_KEY_ICON             = "Icon"
+	KEY_FILE_DESKTOP_KEY_HIDDEN           = "Hidden"
+	KEY_FILE_DESKTOP_KEY_ONLY_SHOW_IN     = "OnlyShowIn"
+	KEY_FILE_DESKTOP_KEY_NOT_SHOW_IN      = "NotShowIn"
+	KEY_FILE_DESKTOP_KEY_TRY_EXEC         = "TryExec"
+	KEY_FILE_DESKTOP_KEY_EXEC             = "Exec"
+	KEY_FILE_DESKTOP_KEY_PATH             = "Path"
+	KEY_FILE_DESKTOP_KEY_TERMINAL         = "Terminal"
+	KEY_FILE_DESKTOP_KEY_MIME_TYPE        = "MimeType"
+	KEY_FILE_DESKTOP_KEY_CATEGORIES       = "Categories"
+	KEY_FILE_DESKTOP_KEY_STARTUP_NOTIFY   = "StartupNotify"
+	KEY_FILE_DESKTOP_KEY_STARTUP_WM_CLASS = "StartupWMClass"
+	KEY_FILE_DESKTOP_KEY_URL              = "URL"
+	KEY_FILE_DESKTOP_KEY_ACTIONS          = "Actions"
+
+	KEY_FILE_DESKTOP_TYPE_APPLICATION = "Application"
+	KEY_FILE_DESKTOP_TYPE_LINK        = "Link"
+	KEY_FILE_DESKTOP_TYPE_DIRECTORY   = "Directory"
+)
+
+// KeyFile is a representation of GLib's GKeyFile.
+type KeyFile struct {
+	gKeyFile *C.GKeyFile
+}
+
+// native returns a pointer to the underlying GKeyFile.
+func (v *KeyFile) native() *C.GKeyFile {
+	if v == nil {
+		return nil
+	}
+	return v.gKeyFile
+}
+
+// Native returns a pointer to the underlying GKeyFile.
+func (v *KeyFile) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// wrapKeyFile wraps a native GKeyFile, taking ownership of the reference.
+func wrapKeyFile(p *C.GKeyFile) *KeyFile {
+	if p == nil {
+		return nil
+	}
+	kf := &KeyFile{gKeyFile: p}
+	runtime.SetFinalizer(kf, (*KeyFile).free)
+	return kf
+}
+
+// Ref is a wrapper around g_key_file_ref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this.
+func (v *KeyFile) Ref() {
+	C.g_key_file_ref(v.native())
+}
+
+// Unref is a wrapper around g_key_file_unref().
+// Reference counting is usually handled in the gotk layer,
+// most applications should not need to call this. Unref releases the
+// reference held by v in place of the finalizer, and v must not be used
+// afterwards.
+func (v *KeyFile) Unref() {
+	runtime.SetFinalizer(v, nil)
+	v.free()
+}
+
+// free releases the reference held by v, from the finalizer or Unref.
+func (v *KeyFile) free() {
+	C.g_key_file_unref(v.native())
+}
+
+// TakeKeyFile wraps a unsafe.Pointer as a glib.KeyFile, taking a new
+// reference to it.
+// This function is exported for visibility in other gotk3 packages and
+// is not meant to be used by applications.
+func TakeKeyFile(ptr unsafe.Pointer) *KeyFile {
+	if ptr == nil {
+		return nil
+	}
+	return wrapKeyFile(C.g_key_file_ref((*C.GKeyFile)(ptr)))
+}
+
+// KeyFileNew is a wrapper around g_key_file_new().
+func KeyFileNew() (*KeyFile, error) {
+	c := C.g_key_file_new()
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapKeyFile(c), nil
+}
+
+// SetListSeparator is a wrapper around g_key_file_set_list_separator().
+func (v *KeyFile) SetListSeparator(separator byte) {
+	C.g_key_file_set_list_separator(v.native(), C.gchar(separator))
+}
+
+// LoadFromFile is a wrapper around g_key_file_load_from_file().
+func (v *KeyFile) LoadFromFile(file string, flags KeyFileFlags) error {
+	cstr := (*C.gchar)(C.CString(file))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_load_from_file(v.native(), cstr, C.GKeyFileFlags(flags), &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// LoadFromData is a wrapper around g_key_file_load_from_data().
+func (v *KeyFile) LoadFromData(data string, flags KeyFileFlags) error {
+	cstr := (*C.gchar)(C.CString(data))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_load_from_data(v.native(), cstr, C.gsize(len(data)), C.GKeyFileFlags(flags), &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// LoadFromDataDirs is a wrapper around g_key_file_load_from_data_dirs().
+// It returns the full path of the file that was loaded.
+func (v *KeyFile) LoadFromDataDirs(file string, flags KeyFileFlags) (string, error) {
+	cstr := (*C.gchar)(C.CString(file))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var fullPath *C.gchar
+	var err *C.GError
+	c := C.g_key_file_load_from_data_dirs(v.native(), cstr, &fullPath, C.GKeyFileFlags(flags), &err)
+	if !gobool(c) {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(fullPath))
+	return C.GoString((*C.char)(fullPath)), nil
+}
+
+// LoadFromDirs is a wrapper around g_key_file_load_from_dirs(). It returns
+// the full path of the file that was loaded.
+func (v *KeyFile) LoadFromDirs(file string, searchDirs []string, flags KeyFileFlags) (string, error) {
+	cstr := (*C.gchar)(C.CString(file))
+	defer C.free(unsafe.Pointer(cstr))
+
+	cdirs := C.make_strings(C.int(len(searchDirs) + 1))
+	defer C.destroy_strings(cdirs)
+	for i, dir := range searchDirs {
+		cdir := C.CString(dir)
+		defer C.free(unsafe.Pointer(cdir))
+		C.set_string(cdirs, C.int(i), cdir)
+	}
+	C.set_string(cdirs, C.int(len(searchDirs)), nil)
+
+	var fullPath *C.gchar
+	var err *C.GError
+	c := C._g_key_file_load_from_dirs(v.native(), cstr, cdirs, &fullPath, C.GKeyFileFlags(flags), &err)
+	if !gobool(c) {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(fullPath))
+	return C.GoString((*C.char)(fullPath)), nil
+}
+
+// ToData is a wrapper around g_key_file_to_data().
+func (v *KeyFile) ToData() (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_to_data(v.native(), &length, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoStringN((*C.char)(c), C.int(length)), nil
+}
+
+// SaveToFile is a wrapper around g_key_file_save_to_file().
+func (v *KeyFile) SaveToFile(filename string) error {
+	cstr := (*C.gchar)(C.CString(filename))
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_save_to_file(v.native(), cstr, &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// GetStartGroup is a wrapper around g_key_file_get_start_group().
+func (v *KeyFile) GetStartGroup() string {
+	c := C.g_key_file_get_start_group(v.native())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c))
+}
+
+// GetGroups is a wrapper around g_key_file_get_groups().
+func (v *KeyFile) GetGroups() []string {
+	return toGoStringArray(C.g_key_file_get_groups(v.native(), nil))
+}
+
+// GetKeys is a wrapper around g_key_file_get_keys().
+func (v *KeyFile) GetKeys(group string) ([]string, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+
+	var err *C.GError
+	c := C.g_key_file_get_keys(v.native(), cgroup, nil, &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return toGoStringArray(c), nil
+}
+
+// HasGroup is a wrapper around g_key_file_has_group().
+func (v *KeyFile) HasGroup(group string) bool {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+
+	return gobool(C.g_key_file_has_group(v.native(), cgroup))
+}
+
+// HasKey is a wrapper around g_key_file_has_key().
+func (v *KeyFile) HasKey(group, key string) (bool, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_has_key(v.native(), cgroup, ckey, &err)
+	if err != nil {
+		return false, takeError(err)
+	}
+	return gobool(c), nil
+}
+
+// RemoveGroup is a wrapper around g_key_file_remove_group().
+func (v *KeyFile) RemoveGroup(group string) error {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+
+	var err *C.GError
+	c := C.g_key_file_remove_group(v.native(), cgroup, &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// RemoveKey is a wrapper around g_key_file_remove_key().
+func (v *KeyFile) RemoveKey(group, key string) error {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_remove_key(v.native(), cgroup, ckey, &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * Comments
+ */
+
+// optionalCString returns a C copy of s, or NULL if s is empty.
+func optionalCString(s string) *C.gchar {
+	if s == "" {
+		return nil
+	}
+	return (*C.gchar)(C.CString(s))
+}
+
+// GetComment is a wrapper around g_key_file_get_comment(). An empty key
+// returns the comment above group, and an empty group returns the comment
+// at the top of the file.
+func (v *KeyFile) GetComment(group, key string) (string, error) {
+	cgroup := optionalCString(group)
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := optionalCString(key)
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_comment(v.native(), cgroup, ckey, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c)), nil
+}
+
+// SetComment is a wrapper around g_key_file_set_comment(). An empty key
+// sets the comment above group, and an empty group sets the comment at
+// the top of the file.
+func (v *KeyFile) SetComment(group, key, comment string) error {
+	cgroup := optionalCString(group)
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := optionalCString(key)
+	defer C.free(unsafe.Pointer(ckey))
+	ccomment := (*C.gchar)(C.CString(comment))
+	defer C.free(unsafe.Pointer(ccomment))
+
+	var err *C.GError
+	c := C.g_key_file_set_comment(v.native(), cgroup, ckey, ccomment, &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+// RemoveComment is a wrapper around g_key_file_remove_comment(). Empty
+// group and key have the same meaning as in GetComment.
+func (v *KeyFile) RemoveComment(group, key string) error {
+	cgroup := optionalCString(group)
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := optionalCString(key)
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_remove_comment(v.native(), cgroup, ckey, &err)
+	if !gobool(c) {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * Values
+ */
+
+// GetValue is a wrapper around g_key_file_get_value().
+func (v *KeyFile) GetValue(group, key string) (string, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_value(v.native(), cgroup, ckey, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c)), nil
+}
+
+// SetValue is a wrapper around g_key_file_set_value().
+func (v *KeyFile) SetValue(group, key, value string) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+	cvalue := (*C.gchar)(C.CString(value))
+	defer C.free(unsafe.Pointer(cvalue))
+
+	C.g_key_file_set_value(v.native(), cgroup, ckey, cvalue)
+}
+
+// GetString is a wrapper around g_key_file_get_string().
+func (v *KeyFile) GetString(group, key string) (string, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_string(v.native(), cgroup, ckey, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c)), nil
+}
+
+// SetString is a wrapper around g_key_file_set_string().
+func (v *KeyFile) SetString(group, key, value string) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+	cvalue := (*C.gchar)(C.CString(value))
+	defer C.free(unsafe.Pointer(cvalue))
+
+	C.g_key_file_set_string(v.native(), cgroup, ckey, cvalue)
+}
+
+// GetLocaleString is a wrapper around g_key_file_get_locale_string(). An
+// empty locale uses the current locale.
+func (v *KeyFile) GetLocaleString(group, key, locale string) (string, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+	clocale := optionalCString(locale)
+	defer C.free(unsafe.Pointer(clocale))
+
+	var err *C.GError
+	c := C.g_key_file_get_locale_string(v.native(), cgroup, ckey, clocale, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c)), nil
+}
+
+// SetLocaleString is a wrapper around g_key_file_set_locale_string().
+func (v *KeyFile) SetLocaleString(group, key, locale, value string) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+	clocale := (*C.gchar)(C.CString(locale))
+	defer C.free(unsafe.Pointer(clocale))
+	cvalue := (*C.gchar)(C.CString(value))
+	defer C.free(unsafe.Pointer(cvalue))
+
+	C.g_key_file_set_locale_string(v.native(), cgroup, ckey, clocale, cvalue)
+}
+
+// GetBoolean is a wrapper around g_key_file_get_boolean().
+func (v *KeyFile) GetBoolean(group, key string) (bool, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_boolean(v.native(), cgroup, ckey, &err)
+	if err != nil {
+		return false, takeError(err)
+	}
+	return gobool(c), nil
+}
+
+// SetBoolean is a wrapper around g_key_file_set_boolean().
+func (v *KeyFile) SetBoolean(group, key string, value bool) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	C.g_key_file_set_boolean(v.native(), cgroup, ckey, gbool(value))
+}
+
+// GetInteger is a wrapper around g_key_file_get_integer().
+func (v *KeyFile) GetInteger(group, key string) (int, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_integer(v.native(), cgroup, ckey, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int(c), nil
+}
+
+// SetInteger is a wrapper around g_key_file_set_integer().
+func (v *KeyFile) SetInteger(group, key string, value int) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	C.g_key_file_set_integer(v.native(), cgroup, ckey, C.gint(value))
+}
+
+// GetInt64 is a wrapper around g_key_file_get_int64().
+func (v *KeyFile) GetInt64(group, key string) (int64, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_int64(v.native(), cgroup, ckey, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int64(c), nil
+}
+
+// SetInt64 is a wrapper around g_key_file_set_int64().
+func (v *KeyFile) SetInt64(group, key string, value int64) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	C.g_key_file_set_int64(v.native(), cgroup, ckey, C.gint64(value))
+}
+
+// GetUint64 is a wrapper around g_key_file_get_uint64().
+func (v *KeyFile) GetUint64(group, key string) (uint64, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_uint64(v.native(), cgroup, ckey, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return uint64(c), nil
+}
+
+// SetUint64 is a wrapper around g_key_file_set_uint64().
+func (v *KeyFile) SetUint64(group, key string, value uint64) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	C.g_key_file_set_uint64(v.native(), cgroup, ckey, C.guint64(value))
+}
+
+// GetDouble is a wrapper around g_key_file_get_double().
+func (v *KeyFile) GetDouble(group, key string) (float64, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_double(v.native(), cgroup, ckey, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return float64(c), nil
+}
+
+// SetDouble is a wrapper around g_key_file_set_double().
+func (v *KeyFile) SetDouble(group, key string, value float64) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	C.g_key_file_set_double(v.native(), cgroup, ckey, C.gdouble(value))
+}
+
+/*
+ * Lists
+ */
+
+// GetStringList is a wrapper around g_key_file_get_string_list().
+func (v *KeyFile) GetStringList(group, key string) ([]string, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var err *C.GError
+	c := C.g_key_file_get_string_list(v.native(), cgroup, ckey, nil, &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return toGoStringArray(c), nil
+}
+
+// SetStringList is a wrapper around g_key_file_set_string_list().
+func (v *KeyFile) SetStringList(group, key string, list []string) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	clist := C.make_strings(C.int(len(list) + 1))
+	defer C.destroy_strings(clist)
+	for i, s := range list {
+		cstr := C.CString(s)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(clist, C.int(i), cstr)
+	}
+	C.set_string(clist, C.int(len(list)), nil)
+
+	C._g_key_file_set_string_list(v.native(), cgroup, ckey, clist, C.gsize(len(list)))
+}
+
+// GetLocaleStringList is a wrapper around g_key_file_get_locale_string_list().
+// An empty locale uses the current locale.
+func (v *KeyFile) GetLocaleStringList(group, key, locale string) ([]string, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+	clocale := optionalCString(locale)
+	defer C.free(unsafe.Pointer(clocale))
+
+	var err *C.GError
+	c := C.g_key_file_get_locale_string_list(v.native(), cgroup, ckey, clocale, nil, &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return toGoStringArray(c), nil
+}
+
+// SetLocaleStringList is a wrapper around g_key_file_set_locale_string_list().
+func (v *KeyFile) SetLocaleStringList(group, key, locale string, list []string) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+	clocale := (*C.gchar)(C.CString(locale))
+	defer C.free(unsafe.Pointer(clocale))
+
+	clist := C.make_strings(C.int(len(list) + 1))
+	defer C.destroy_strings(clist)
+	for i, s := range list {
+		cstr := C.CString(s)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(clist, C.int(i), cstr)
+	}
+	C.set_string(clist, C.int(len(list)), nil)
+
+	C._g_key_file_set_locale_string_list(v.native(), cgroup, ckey, clocale, clist, C.gsize(len(list)))
+}
+
+// GetBooleanList is a wrapper around g_key_file_get_boolean_list().
+func (v *KeyFile) GetBooleanList(group, key string) ([]bool, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_get_boolean_list(v.native(), cgroup, ckey, &length, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	var cslice []C.gboolean
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&cslice))
+	header.Data = uintptr(unsafe.Pointer(c))
+	header.Len = int(length)
+	header.Cap = int(length)
+
+	list := make([]bool, len(cslice))
+	for i, b := range cslice {
+		list[i] = gobool(b)
+	}
+	return list, nil
+}
+
+// SetBooleanList is a wrapper around g_key_file_set_boolean_list().
+func (v *KeyFile) SetBooleanList(group, key string, list []bool) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	clist := make([]C.gboolean, len(list)+1)
+	for i, b := range list {
+		clist[i] = gbool(b)
+	}
+
+	C.g_key_file_set_boolean_list(v.native(), cgroup, ckey, &clist[0], C.gsize(len(list)))
+}
+
+// GetIntegerList is a wrapper around g_key_file_get_integer_list().
+func (v *KeyFile) GetIntegerList(group, key string) ([]int, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_get_integer_list(v.native(), cgroup, ckey, &length, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	var cslice []C.gint
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&cslice))
+	header.Data = uintptr(unsafe.Pointer(c))
+	header.Len = int(length)
+	header.Cap = int(length)
+
+	list := make([]int, len(cslice))
+	for i, n := range cslice {
+		list[i] = int(n)
+	}
+	return list, nil
+}
+
+// SetIntegerList is a wrapper around g_key_file_set_integer_list().
+func (v *KeyFile) SetIntegerList(group, key string, list []int) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	clist := make([]C.gint, len(list)+1)
+	for i, n := range list {
+		clist[i] = C.gint(n)
+	}
+
+	C.g_key_file_set_integer_list(v.native(), cgroup, ckey, &clist[0], C.gsize(len(list)))
+}
+
+// GetDoubleList is a wrapper around g_key_file_get_double_list().
+func (v *KeyFile) GetDoubleList(group, key string) ([]float64, error) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_get_double_list(v.native(), cgroup, ckey, &length, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	var cslice []C.gdouble
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&cslice))
+	header.Data = uintptr(unsafe.Pointer(c))
+	header.Len = int(length)
+	header.Cap = int(length)
+
+	list := make([]float64, len(cslice))
+	for i, d := range cslice {
+		list[i] = float64(d)
+	}
+	return list, nil
+}
+
+// SetDoubleList is a wrapper around g_key_file_set_double_list().
+func (v *KeyFile) SetDoubleList(group, key string, list []float64) {
+	cgroup := (*C.gchar)(C.CString(group))
+	defer C.free(unsafe.Pointer(cgroup))
+	ckey := (*C.gchar)(C.CString(key))
+	defer C.free(unsafe.Pointer(ckey))
+
+	clist := make([]C.gdouble, len(list)+1)
+	for i, d := range list {
+		clist[i] = C.gdouble(d)
+	}
+
+	C.g_key_file_set_double_list(v.native(), cgroup, ckey, &clist[0], C.gsize(len(list)))
+}
@@ -0,0 +1,31 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <glib.h>
+
+static inline gboolean _g_key_file_load_from_dirs (GKeyFile *key_file,
+												const gchar *file,
+												char **search_dirs,
+												gchar **full_path,
+												GKeyFileFlags flags,
+												GError **error) {
+	return g_key_file_load_from_dirs(key_file, file, (const gchar **)(search_dirs), full_path, flags, error);
+}
+
+static inline void _g_key_file_set_string_list (GKeyFile *key_file,
+												const gchar *group_name,
+												const gchar *key,
+												char **list,
+												gsize length) {
+	g_key_file_set_string_list(key_file, group_name, key, (const gchar * const *)(list), length);
+}
+
+static inline void _g_key_file_set_locale_string_list (GKeyFile *key_file,
+														const gchar *group_name,
+														const gchar *key,
+														const gchar *locale,
+														char **list,
+														gsize length) {
+	g_key_file_set_locale_string_list(key_file, group_name, key, locale, (const gchar * const *)(list), length);
+}
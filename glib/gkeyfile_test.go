@@ -0,0 +1,214 @@
+package glib_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+const desktopEntry = `# Written by another GNOME tool
+[Desktop Entry]
+Type=Application
+Name=Text Editor
+Name[de]=Texteditor
+Exec=gedit %U
+Terminal=false
+Categories=GNOME;GTK;Utility;
+X-Priority=3
+X-Scale=1.5
+`
+
+func TestKeyFileLoadFromData(t *testing.T) {
+	kf, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := kf.LoadFromData(desktopEntry, glib.KEY_FILE_KEEP_COMMENTS|glib.KEY_FILE_KEEP_TRANSLATIONS); err != nil {
+		t.Fatal(err)
+	}
+
+	if g := kf.GetStartGroup(); g != glib.KEY_FILE_DESKTOP_GROUP {
+		t.Errorf("expected start group %q, got %q", glib.KEY_FILE_DESKTOP_GROUP, g)
+	}
+
+	name, err := kf.GetLocaleString(glib.KEY_FILE_DESKTOP_GROUP, glib.KEY_FILE_DESKTOP_KEY_NAME, "de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Texteditor" {
+		t.Errorf("expected localized name %q, got %q", "Texteditor", name)
+	}
+
+	terminal, err := kf.GetBoolean(glib.KEY_FILE_DESKTOP_GROUP, glib.KEY_FILE_DESKTOP_KEY_TERMINAL)
+	if err != nil || terminal {
+		t.Errorf("expected Terminal=false, got %v (%v)", terminal, err)
+	}
+
+	priority, err := kf.GetInteger(glib.KEY_FILE_DESKTOP_GROUP, "X-Priority")
+	if err != nil || priority != 3 {
+		t.Errorf("expected X-Priority=3, got %d (%v)", priority, err)
+	}
+
+	scale, err := kf.GetDouble(glib.KEY_FILE_DESKTOP_GROUP, "X-Scale")
+	if err != nil || scale != 1.5 {
+		t.Errorf("expected X-Scale=1.5, got %v (%v)", scale, err)
+	}
+
+	categories, err := kf.GetStringList(glib.KEY_FILE_DESKTOP_GROUP, glib.KEY_FILE_DESKTOP_KEY_CATEGORIES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(categories, []string{"GNOME", "GTK", "Utility"}) {
+		t.Errorf("unexpected categories %v", categories)
+	}
+
+	comment, err := kf.GetComment("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(comment, "Written by another GNOME tool") {
+		t.Errorf("unexpected comment %q", comment)
+	}
+
+	keys, err := kf.GetKeys(glib.KEY_FILE_DESKTOP_GROUP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 8 {
+		t.Errorf("expected 8 keys, got %v", keys)
+	}
+}
+
+func TestKeyFileRoundTrip(t *testing.T) {
+	kf, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kf.SetString("General", "title", "Hello, World")
+	kf.SetLocaleString("General", "title", "fr", "Bonjour")
+	kf.SetBoolean("General", "enabled", true)
+	kf.SetInteger("General", "count", -42)
+	kf.SetInt64("General", "big", 1<<40)
+	kf.SetDouble("General", "ratio", 0.25)
+	kf.SetStringList("Lists", "names", []string{"a", "b;c", "d"})
+	kf.SetBooleanList("Lists", "flags", []bool{true, false, true})
+	kf.SetIntegerList("Lists", "numbers", []int{1, 2, 3})
+	kf.SetDoubleList("Lists", "doubles", []float64{0.5, 1.5})
+	if err := kf.SetComment("General", "", " General settings"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := kf.ToData()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "gotk3-keyfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "settings.ini"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := loaded.LoadFromDirs("settings.ini", []string{dir}, glib.KEY_FILE_KEEP_COMMENTS|glib.KEY_FILE_KEEP_TRANSLATIONS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != filepath.Join(dir, "settings.ini") {
+		t.Errorf("unexpected path %q", path)
+	}
+
+	if !reflect.DeepEqual(loaded.GetGroups(), []string{"General", "Lists"}) {
+		t.Errorf("unexpected groups %v", loaded.GetGroups())
+	}
+	if s, _ := loaded.GetString("General", "title"); s != "Hello, World" {
+		t.Errorf("unexpected title %q", s)
+	}
+	if s, _ := loaded.GetLocaleString("General", "title", "fr"); s != "Bonjour" {
+		t.Errorf("unexpected french title %q", s)
+	}
+	if b, _ := loaded.GetBoolean("General", "enabled"); !b {
+		t.Error("expected enabled to be true")
+	}
+	if n, _ := loaded.GetInteger("General", "count"); n != -42 {
+		t.Errorf("unexpected count %d", n)
+	}
+	if n, _ := loaded.GetInt64("General", "big"); n != 1<<40 {
+		t.Errorf("unexpected big %d", n)
+	}
+	if d, _ := loaded.GetDouble("General", "ratio"); d != 0.25 {
+		t.Errorf("unexpected ratio %v", d)
+	}
+	if l, _ := loaded.GetStringList("Lists", "names"); !reflect.DeepEqual(l, []string{"a", "b;c", "d"}) {
+		t.Errorf("unexpected names %v", l)
+	}
+	if l, _ := loaded.GetBooleanList("Lists", "flags"); !reflect.DeepEqual(l, []bool{true, false, true}) {
+		t.Errorf("unexpected flags %v", l)
+	}
+	if l, _ := loaded.GetIntegerList("Lists", "numbers"); !reflect.DeepEqual(l, []int{1, 2, 3}) {
+		t.Errorf("unexpected numbers %v", l)
+	}
+	if l, _ := loaded.GetDoubleList("Lists", "doubles"); !reflect.DeepEqual(l, []float64{0.5, 1.5}) {
+		t.Errorf("unexpected doubles %v", l)
+	}
+	if c, _ := loaded.GetComment("General", ""); !strings.Contains(c, "General settings") {
+		t.Errorf("unexpected comment %q", c)
+	}
+
+	again, err := loaded.ToData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != data {
+		t.Errorf("round trip changed data:\n%s\nvs\n%s", data, again)
+	}
+}
+
+func TestKeyFileErrors(t *testing.T) {
+	kf, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kf.SetString("General", "count", "not a number")
+
+	_, err = kf.GetString("Missing", "key")
+	if !errors.Is(err, glib.NewError(glib.KeyFileErrorQuark(), int(glib.KEY_FILE_ERROR_GROUP_NOT_FOUND), "")) {
+		t.Errorf("expected group not found error, got %v", err)
+	}
+
+	_, err = kf.GetInteger("General", "count")
+	if !errors.Is(err, glib.NewError(glib.KeyFileErrorQuark(), int(glib.KEY_FILE_ERROR_INVALID_VALUE), "")) {
+		t.Errorf("expected invalid value error, got %v", err)
+	}
+
+	if err := kf.LoadFromData("this is [not a key file", glib.KEY_FILE_NONE); err == nil {
+		t.Error("expected a parse error")
+	}
+}
+
+func TestKeyFileExplicitUnref(t *testing.T) {
+	kf, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kf.SetString("Group", "Key", "Value")
+	kf.Unref()
+
+	// The finalizer must not release the reference a second time.
+	kf = nil
+	runtime.GC()
+	runtime.GC()
+}
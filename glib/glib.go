@@ -1468,6 +1468,20 @@ func InitI18n(domain string, dir string) {
 	C.init_i18n(domainStr, dirStr)
 }
 
+// getLocale returns the current locale of all categories, as reported by
+// setlocale(LC_ALL, NULL).
+func getLocale() string {
+	return C.GoString(C.setlocale(C.LC_ALL, nil))
+}
+
+// setLocale is a wrapper around setlocale(LC_ALL, locale).
+func setLocale(locale string) {
+	cstr := C.CString(locale)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.setlocale(C.LC_ALL, cstr)
+}
+
 // Local localizes a string using gettext
 func Local(input string) string {
 	cstr := C.CString(input)
@@ -1475,3 +1489,92 @@ func Local(input string) string {
 
 	return C.GoString(C.localize(cstr))
 }
+
+// LocalPlural localizes a string with plural forms using ngettext in the
+// current text domain. n selects the plural form.
+func LocalPlural(msgid, msgidPlural string, n uint) string {
+	return DNGettext("", msgid, msgidPlural, n)
+}
+
+// LocalContext localizes a context-qualified string in the current text
+// domain, like pgettext or the C_() macro.
+func LocalContext(context, msgid string) string {
+	return DPGettext2("", context, msgid)
+}
+
+// DGettext is a wrapper around g_dgettext(). An empty domain uses the
+// current text domain.
+func DGettext(domain, msgid string) string {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+	cmsgid := (*C.gchar)(C.CString(msgid))
+	defer C.free(unsafe.Pointer(cmsgid))
+
+	return C.GoString((*C.char)(C.g_dgettext(cdomain, cmsgid)))
+}
+
+// DNGettext is a wrapper around g_dngettext(). An empty domain uses the
+// current text domain.
+func DNGettext(domain, msgid, msgidPlural string, n uint) string {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+	cmsgid := (*C.gchar)(C.CString(msgid))
+	defer C.free(unsafe.Pointer(cmsgid))
+	cplural := (*C.gchar)(C.CString(msgidPlural))
+	defer C.free(unsafe.Pointer(cplural))
+
+	return C.GoString((*C.char)(C.g_dngettext(cdomain, cmsgid, cplural, C.gulong(n))))
+}
+
+// DPGettext2 is a wrapper around g_dpgettext2(). An empty domain uses the
+// current text domain.
+func DPGettext2(domain, context, msgid string) string {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+	ccontext := (*C.gchar)(C.CString(context))
+	defer C.free(unsafe.Pointer(ccontext))
+	cmsgid := (*C.gchar)(C.CString(msgid))
+	defer C.free(unsafe.Pointer(cmsgid))
+
+	return C.GoString((*C.char)(C.g_dpgettext2(cdomain, ccontext, cmsgid)))
+}
+
+// BindTextdomain is a wrapper around bindtextdomain(). It returns the
+// directory the domain is now bound to.
+func BindTextdomain(domain, dir string) string {
+	cdomain := C.CString(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+	cdir := C.CString(dir)
+	defer C.free(unsafe.Pointer(cdir))
+
+	return C.GoString(C.bindtextdomain(cdomain, cdir))
+}
+
+// BindTextdomainCodeset is a wrapper around bind_textdomain_codeset(). It
+// returns the codeset the domain is now bound to.
+func BindTextdomainCodeset(domain, codeset string) string {
+	cdomain := C.CString(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+	ccodeset := C.CString(codeset)
+	defer C.free(unsafe.Pointer(ccodeset))
+
+	return C.GoString(C.bind_textdomain_codeset(cdomain, ccodeset))
+}
+
+// Textdomain is a wrapper around textdomain(). It sets the current text
+// domain used by Local, LocalPlural and LocalContext.
+func Textdomain(domain string) {
+	cdomain := C.CString(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+
+	C.textdomain(cdomain)
+}
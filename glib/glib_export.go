@@ -32,3 +32,17 @@ func goCompareDataFuncs(a, b C.gconstpointer, userData C.gpointer) {
 
 	r.fn(uintptr(a), uintptr(b), r.userData)
 }
+
+//export goLogFunc
+func goLogFunc(logDomain *C.gchar, logLevel C.GLogLevelFlags, message *C.gchar, userData C.gpointer) {
+	id := int(uintptr(userData))
+
+	logFuncRegistry.RLock()
+	fn := logFuncRegistry.m[id]
+	logFuncRegistry.RUnlock()
+
+	if fn == nil {
+		return
+	}
+	fn(C.GoString((*C.char)(logDomain)), LogLevelFlags(logLevel), C.GoString((*C.char)(message)))
+}
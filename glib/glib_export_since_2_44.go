@@ -0,0 +1,59 @@
+// +build !glib_2_40,!glib_2_42
+
+package glib
+
+// #include <glib.h>
+// #include <glib-object.h>
+import "C"
+
+//export goListModelGetNItems
+func goListModelGetNItems(id C.guint) C.guint {
+	goListModelRegistry.RLock()
+	source := goListModelRegistry.m[int(id)]
+	goListModelRegistry.RUnlock()
+
+	if source == nil {
+		return 0
+	}
+	return C.guint(source.GetNItems())
+}
+
+//export goListModelGetItem
+func goListModelGetItem(id, position C.guint) C.gpointer {
+	goListModelRegistry.RLock()
+	source := goListModelRegistry.m[int(id)]
+	goListModelRegistry.RUnlock()
+
+	if source == nil {
+		return nil
+	}
+
+	// GListModel returns items with a full reference.
+	switch item := source.GetItem(uint(position)).(type) {
+	case nil:
+		return nil
+	case IObject:
+		obj := item.toGObject()
+		if obj == nil {
+			return nil
+		}
+		C.g_object_ref(C.gpointer(obj))
+		return C.gpointer(obj)
+	default:
+		return C.gpointer(newGoListItem(item))
+	}
+}
+
+//export goListModelFinalize
+func goListModelFinalize(id C.guint) {
+	goListModelRegistry.Lock()
+	delete(goListModelRegistry.m, int(id))
+	goListModelRegistry.Unlock()
+}
+
+//export goListItemFinalize
+func goListItemFinalize(id C.guint) {
+	goListItemRegistry.Lock()
+	delete(goListItemRegistry.m, int(id))
+	goListItemRegistry.Unlock()
+}
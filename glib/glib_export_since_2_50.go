@@ -0,0 +1,15 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib
+
+// #include <glib.h>
+import "C"
+
+//export goLogWriterFunc
+func goLogWriterFunc(logLevel C.GLogLevelFlags, fields *C.GLogField, nFields C.gsize, userData C.gpointer) C.GLogWriterOutput {
+	fn := logGetWriterFunc()
+	if fn == nil {
+		return C.G_LOG_WRITER_UNHANDLED
+	}
+	return C.GLogWriterOutput(fn(LogLevelFlags(logLevel), logFields(fields, nFields)))
+}
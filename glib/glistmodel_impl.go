@@ -0,0 +1,168 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42
+
+package glib
+
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "glistmodel_impl.go.h"
+import "C"
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+/*
+ * Go-implemented GListModel
+ */
+
+// ListModelSource provides the items of a GoListModel. GetItem may return
+// any IObject, which is handed to GTK as is, or any other Go value, which
+// is wrapped in a GotkListItem (see GoListItemValue). Returning nil means
+// there is no item at position.
+type ListModelSource interface {
+	GetNItems() uint
+	GetItem(position uint) interface{}
+}
+
+// ListModelFuncs adapts a pair of callbacks to a ListModelSource.
+type ListModelFuncs struct {
+	NItems func() uint
+	Item   func(position uint) interface{}
+}
+
+// GetNItems calls f.NItems.
+func (f ListModelFuncs) GetNItems() uint {
+	return f.NItems()
+}
+
+// GetItem calls f.Item.
+func (f ListModelFuncs) GetItem(position uint) interface{} {
+	return f.Item(position)
+}
+
+// sliceListModelSource serves the elements of a Go slice, read through a
+// pointer so that the slice may grow and shrink between calls.
+type sliceListModelSource struct {
+	slice reflect.Value
+}
+
+func (s sliceListModelSource) GetNItems() uint {
+	return uint(s.slice.Len())
+}
+
+func (s sliceListModelSource) GetItem(position uint) interface{} {
+	if position >= uint(s.slice.Len()) {
+		return nil
+	}
+	return s.slice.Index(int(position)).Interface()
+}
+
+var (
+	goListModelRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]ListModelSource
+	}{
+		next: 1,
+		m:    make(map[int]ListModelSource),
+	}
+
+	goListItemRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]interface{}
+	}{
+		next: 1,
+		m:    make(map[int]interface{}),
+	}
+)
+
+// GoListModel is a GListModel implemented in Go. It can be used anywhere a
+// ListModel is expected, such as gtk.ListBox.BindModel, without copying
+// the items into a ListStore. Call ItemsChanged after changing the data
+// behind the source.
+type GoListModel struct {
+	ListModel
+}
+
+// GoListModelNew creates a GoListModel reporting itemType from
+// GetItemType and serving the items of source. itemType should be
+// GoListItemGetType() if source returns values that are not GObjects.
+func GoListModelNew(itemType Type, source ListModelSource) (*GoListModel, error) {
+	if source == nil {
+		return nil, errors.New("source is nil")
+	}
+
+	goListModelRegistry.Lock()
+	id := goListModelRegistry.next
+	goListModelRegistry.next++
+	goListModelRegistry.m[id] = source
+	goListModelRegistry.Unlock()
+
+	c := C._gotk_list_model_new(C.GType(itemType), C.guint(id))
+	if c == nil {
+		goListModelRegistry.Lock()
+		delete(goListModelRegistry.m, id)
+		goListModelRegistry.Unlock()
+		return nil, nilPtrErr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject took its own reference, drop the one from g_object_new so
+	// the model, and its source, are released once unused.
+	C.g_object_unref(C.gpointer(c))
+	return &GoListModel{ListModel{obj}}, nil
+}
+
+// GoListModelNewFromSlice creates a GoListModel serving the elements of
+// the slice slicePtr points to, wrapped in GotkListItems. The slice is
+// read on every access, so after appending, removing or replacing
+// elements call ItemsChanged to notify views.
+func GoListModelNewFromSlice(slicePtr interface{}) (*GoListModel, error) {
+	v := reflect.ValueOf(slicePtr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return nil, errors.New("slicePtr is not a pointer to a slice")
+	}
+	return GoListModelNew(GoListItemGetType(), sliceListModelSource{v.Elem()})
+}
+
+// GoListItemGetType returns the GType of GotkListItem, the GObject used by
+// GoListModel to carry Go values that are not GObjects.
+func GoListItemGetType() Type {
+	return Type(C.gotk_list_item_get_type())
+}
+
+// GoListItemValue returns the Go value carried by a GotkListItem, as passed
+// to the create-widget callbacks of gtk.ListBox.BindModel. The boolean is
+// false if obj is not a GotkListItem.
+func GoListItemValue(obj IObject) (interface{}, bool) {
+	if obj == nil {
+		return nil, false
+	}
+	id := int(C._gotk_list_item_get_id(obj.toGObject()))
+	if id == 0 {
+		return nil, false
+	}
+
+	goListItemRegistry.RLock()
+	defer goListItemRegistry.RUnlock()
+	v, ok := goListItemRegistry.m[id]
+	return v, ok
+}
+
+// newGoListItem wraps value in a new GotkListItem and returns it with a
+// full reference.
+func newGoListItem(value interface{}) *C.GObject {
+	goListItemRegistry.Lock()
+	id := goListItemRegistry.next
+	goListItemRegistry.next++
+	goListItemRegistry.m[id] = value
+	goListItemRegistry.Unlock()
+
+	return C._gotk_list_item_new(C.guint(id))
+}
@@ -0,0 +1,111 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gio/gio.h>
+#include <glib.h>
+#include <glib-object.h>
+
+/*
+ * GotkListModel is a GListModel whose items are provided by Go. The id
+ * is the handle of the Go source in goListModelRegistry.
+ */
+
+extern guint goListModelGetNItems (guint id);
+extern gpointer goListModelGetItem (guint id, guint position);
+extern void goListModelFinalize (guint id);
+
+typedef struct {
+	GObject parent_instance;
+	GType item_type;
+	guint id;
+} GotkListModel;
+
+typedef struct {
+	GObjectClass parent_class;
+} GotkListModelClass;
+
+static void gotk_list_model_iface_init (GListModelInterface *iface);
+
+G_DEFINE_TYPE_WITH_CODE (GotkListModel, gotk_list_model, G_TYPE_OBJECT,
+	G_IMPLEMENT_INTERFACE (G_TYPE_LIST_MODEL, gotk_list_model_iface_init))
+
+static GType gotk_list_model_get_item_type (GListModel *list) {
+	return ((GotkListModel *)(list))->item_type;
+}
+
+static guint gotk_list_model_get_n_items (GListModel *list) {
+	return goListModelGetNItems(((GotkListModel *)(list))->id);
+}
+
+static gpointer gotk_list_model_get_item (GListModel *list, guint position) {
+	return goListModelGetItem(((GotkListModel *)(list))->id, position);
+}
+
+static void gotk_list_model_iface_init (GListModelInterface *iface) {
+	iface->get_item_type = gotk_list_model_get_item_type;
+	iface->get_n_items = gotk_list_model_get_n_items;
+	iface->get_item = gotk_list_model_get_item;
+}
+
+static void gotk_list_model_finalize (GObject *object) {
+	goListModelFinalize(((GotkListModel *)(object))->id);
+	G_OBJECT_CLASS(gotk_list_model_parent_class)->finalize(object);
+}
+
+static void gotk_list_model_class_init (GotkListModelClass *klass) {
+	G_OBJECT_CLASS(klass)->finalize = gotk_list_model_finalize;
+}
+
+static void gotk_list_model_init (GotkListModel *self) {
+}
+
+static inline GObject *_gotk_list_model_new (GType item_type, guint id) {
+	GotkListModel *model = g_object_new(gotk_list_model_get_type(), NULL);
+	model->item_type = item_type;
+	model->id = id;
+	return G_OBJECT(model);
+}
+
+/*
+ * GotkListItem is a plain GObject carrying an arbitrary Go value, so Go
+ * values that are not GObjects can be items of a GotkListModel. The id is
+ * the handle of the value in goListItemRegistry.
+ */
+
+extern void goListItemFinalize (guint id);
+
+typedef struct {
+	GObject parent_instance;
+	guint id;
+} GotkListItem;
+
+typedef struct {
+	GObjectClass parent_class;
+} GotkListItemClass;
+
+G_DEFINE_TYPE (GotkListItem, gotk_list_item, G_TYPE_OBJECT)
+
+static void gotk_list_item_finalize (GObject *object) {
+	goListItemFinalize(((GotkListItem *)(object))->id);
+	G_OBJECT_CLASS(gotk_list_item_parent_class)->finalize(object);
+}
+
+static void gotk_list_item_class_init (GotkListItemClass *klass) {
+	G_OBJECT_CLASS(klass)->finalize = gotk_list_item_finalize;
+}
+
+static void gotk_list_item_init (GotkListItem *self) {
+}
+
+static inline GObject *_gotk_list_item_new (guint id) {
+	GotkListItem *item = g_object_new(gotk_list_item_get_type(), NULL);
+	item->id = id;
+	return G_OBJECT(item);
+}
+
+static inline guint _gotk_list_item_get_id (GObject *object) {
+	if (!G_TYPE_CHECK_INSTANCE_TYPE(object, gotk_list_item_get_type()))
+		return 0;
+	return ((GotkListItem *)(object))->id;
+}
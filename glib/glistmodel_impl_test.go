@@ -0,0 +1,69 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42
+
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+type testListItem struct {
+	Name string
+}
+
+func TestGoListModelNewFromSlice(t *testing.T) {
+	items := []testListItem{{"a"}, {"b"}, {"c"}}
+	model, err := glib.GoListModelNewFromSlice(&items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if model.GetItemType() != glib.GoListItemGetType() {
+		t.Errorf("unexpected item type %v", model.GetItemType())
+	}
+	if n := model.GetNItems(); n != 3 {
+		t.Fatalf("expected 3 items, got %d", n)
+	}
+
+	v, ok := glib.GoListItemValue(model.GetObject(1))
+	if !ok {
+		t.Fatal("item is not a GotkListItem")
+	}
+	if item, _ := v.(testListItem); item.Name != "b" {
+		t.Errorf("expected item %q, got %v", "b", v)
+	}
+
+	items = append(items, testListItem{"d"})
+	if n := model.GetNItems(); n != 4 {
+		t.Errorf("expected 4 items after append, got %d", n)
+	}
+	if model.GetItem(4) != 0 {
+		t.Error("expected no item past the end")
+	}
+}
+
+func TestGoListModelFuncs(t *testing.T) {
+	model, err := glib.GoListModelNew(glib.GoListItemGetType(), glib.ListModelFuncs{
+		NItems: func() uint { return 100000 },
+		Item:   func(position uint) interface{} { return position * 2 },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := model.GetNItems(); n != 100000 {
+		t.Fatalf("expected 100000 items, got %d", n)
+	}
+	v, ok := glib.GoListItemValue(model.GetObject(99999))
+	if !ok || v != uint(199998) {
+		t.Errorf("expected 199998, got %v (%v)", v, ok)
+	}
+
+	obj, err := glib.GoListModelNew(glib.GoListItemGetType(), nil)
+	if err == nil || obj != nil {
+		t.Error("expected an error for a nil source")
+	}
+}
@@ -0,0 +1,112 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #include <glib.h>
+// #include "glib.go.h"
+// #include "glog.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+)
+
+/*
+ * Message Logging
+ */
+
+// LogLevelFlags is a representation of GLib's GLogLevelFlags.
+type LogLevelFlags int
+
+const (
+	LOG_FLAG_RECURSION LogLevelFlags = C.G_LOG_FLAG_RECURSION
+	LOG_FLAG_FATAL     LogLevelFlags = C.G_LOG_FLAG_FATAL
+
+	LOG_LEVEL_ERROR    LogLevelFlags = C.G_LOG_LEVEL_ERROR
+	LOG_LEVEL_CRITICAL LogLevelFlags = C.G_LOG_LEVEL_CRITICAL
+	LOG_LEVEL_WARNING  LogLevelFlags = C.G_LOG_LEVEL_WARNING
+	LOG_LEVEL_MESSAGE  LogLevelFlags = C.G_LOG_LEVEL_MESSAGE
+	LOG_LEVEL_INFO     LogLevelFlags = C.G_LOG_LEVEL_INFO
+	LOG_LEVEL_DEBUG    LogLevelFlags = C.G_LOG_LEVEL_DEBUG
+
+	LOG_LEVEL_MASK LogLevelFlags = C.G_LOG_LEVEL_MASK
+)
+
+// Log is a wrapper around g_log(). The message is logged as is and is not
+// used as a format string. Note that messages with LOG_LEVEL_ERROR are
+// always fatal and abort the program.
+func Log(domain string, level LogLevelFlags, message string) {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+	cmsg := (*C.gchar)(C.CString(message))
+	defer C.free(unsafe.Pointer(cmsg))
+
+	C._g_log(cdomain, C.GLogLevelFlags(level), cmsg)
+}
+
+// LogSetAlwaysFatal is a wrapper around g_log_set_always_fatal(). It
+// returns the old fatal mask.
+func LogSetAlwaysFatal(fatalMask LogLevelFlags) LogLevelFlags {
+	return LogLevelFlags(C.g_log_set_always_fatal(C.GLogLevelFlags(fatalMask)))
+}
+
+// LogFunc is a representation of GLogFunc.
+type LogFunc func(domain string, level LogLevelFlags, message string)
+
+var (
+	logFuncRegistry = struct {
+		sync.RWMutex
+		next     int
+		m        map[int]LogFunc
+		handlers map[uint]int
+	}{
+		next:     1,
+		m:        make(map[int]LogFunc),
+		handlers: make(map[uint]int),
+	}
+)
+
+// LogSetHandler is a wrapper around g_log_set_handler(). An empty domain
+// sets the handler for the default domain. The returned handler id can be
+// passed to LogRemoveHandler.
+func LogSetHandler(domain string, levels LogLevelFlags, fn LogFunc) uint {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+
+	logFuncRegistry.Lock()
+	id := logFuncRegistry.next
+	logFuncRegistry.next++
+	logFuncRegistry.m[id] = fn
+	logFuncRegistry.Unlock()
+
+	handlerID := uint(C._g_log_set_handler(cdomain, C.GLogLevelFlags(levels),
+		C.gpointer(uintptr(id))))
+
+	logFuncRegistry.Lock()
+	logFuncRegistry.handlers[handlerID] = id
+	logFuncRegistry.Unlock()
+
+	return handlerID
+}
+
+// LogRemoveHandler is a wrapper around g_log_remove_handler().
+func LogRemoveHandler(domain string, handlerID uint) {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+
+	C.g_log_remove_handler(cdomain, C.guint(handlerID))
+
+	logFuncRegistry.Lock()
+	delete(logFuncRegistry.m, logFuncRegistry.handlers[handlerID])
+	delete(logFuncRegistry.handlers, handlerID)
+	logFuncRegistry.Unlock()
+}
@@ -0,0 +1,26 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <glib.h>
+
+/*
+ * GLogFunc
+ */
+
+extern void goLogFunc (gchar *log_domain,
+						GLogLevelFlags log_level,
+						gchar *message,
+						gpointer user_data);
+
+static inline guint _g_log_set_handler (const gchar *log_domain,
+										GLogLevelFlags log_levels,
+										gpointer user_data) {
+	return g_log_set_handler(log_domain, log_levels, (GLogFunc)(goLogFunc), user_data);
+}
+
+static inline void _g_log (const gchar *log_domain,
+							GLogLevelFlags log_level,
+							const gchar *message) {
+	g_log(log_domain, log_level, "%s", message);
+}
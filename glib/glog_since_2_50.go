@@ -0,0 +1,154 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib
+
+// #include <glib.h>
+// #include "glib.go.h"
+// #include "glog_since_2_50.go.h"
+import "C"
+import (
+	"strings"
+	"sync"
+)
+
+// LogWriterOutput is a representation of GLib's GLogWriterOutput.
+type LogWriterOutput int
+
+const (
+	LOG_WRITER_HANDLED   LogWriterOutput = C.G_LOG_WRITER_HANDLED
+	LOG_WRITER_UNHANDLED LogWriterOutput = C.G_LOG_WRITER_UNHANDLED
+)
+
+// LogField is a representation of GLib's GLogField. Value holds the raw
+// bytes of the field; for the standard fields (MESSAGE, GLIB_DOMAIN,
+// CODE_FILE, CODE_LINE, CODE_FUNC, PRIORITY) it is plain text.
+type LogField struct {
+	Key   string
+	Value string
+}
+
+// LogWriterFunc is a representation of GLogWriterFunc.
+type LogWriterFunc func(level LogLevelFlags, fields []LogField) LogWriterOutput
+
+var (
+	logWriter = struct {
+		sync.RWMutex
+		once sync.Once
+		fn   LogWriterFunc
+	}{}
+)
+
+// LogSetWriterFunc is a wrapper around g_log_set_writer_func(). Unlike the C
+// function, it may be called any number of times: the GLib writer is only
+// installed once and dispatches to the latest fn. Messages for which fn
+// returns LOG_WRITER_UNHANDLED, or all messages if fn is nil, are passed on
+// to g_log_writer_default().
+func LogSetWriterFunc(fn LogWriterFunc) {
+	logWriter.Lock()
+	logWriter.fn = fn
+	logWriter.Unlock()
+
+	logWriter.once.Do(func() {
+		C._g_log_set_writer_func()
+	})
+}
+
+// logGetWriterFunc returns the writer set with LogSetWriterFunc.
+func logGetWriterFunc() LogWriterFunc {
+	logWriter.RLock()
+	defer logWriter.RUnlock()
+	return logWriter.fn
+}
+
+// logFields converts a C array of GLogFields to a Go slice.
+func logFields(fields *C.GLogField, nFields C.gsize) []LogField {
+	s := make([]LogField, 0, int(nFields))
+	for i := C.gsize(0); i < nFields; i++ {
+		f := C._g_log_field_index(fields, i)
+
+		var value string
+		if f.length < 0 {
+			value = C.GoString((*C.char)(f.value))
+		} else {
+			value = C.GoStringN((*C.char)(f.value), C.int(f.length))
+		}
+
+		s = append(s, LogField{
+			Key:   C.GoString((*C.char)(f.key)),
+			Value: value,
+		})
+	}
+	return s
+}
+
+// LogFieldValue returns the value of the first field with the given key,
+// or an empty string if there is none.
+func LogFieldValue(fields []LogField, key string) string {
+	for _, f := range fields {
+		if f.Key == key {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// TestingT is the subset of testing.TB used by FailTestOnLog.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Cleanup(func())
+}
+
+// FailTestOnLog makes t fail whenever a message matching levels is logged
+// through GLib, e.g. a GTK critical such as a failed assertion. Other
+// messages are passed on to the previous writer. The previous writer is
+// restored when the test finishes.
+//
+//	func TestWidget(t *testing.T) {
+//	    glib.FailTestOnLog(t, glib.LOG_LEVEL_CRITICAL|glib.LOG_LEVEL_WARNING)
+//	    ...
+//	}
+func FailTestOnLog(t TestingT, levels LogLevelFlags) {
+	t.Helper()
+
+	prev := logGetWriterFunc()
+	LogSetWriterFunc(func(level LogLevelFlags, fields []LogField) LogWriterOutput {
+		if level&levels != 0 {
+			domain := LogFieldValue(fields, "GLIB_DOMAIN")
+			if domain == "" {
+				domain = "default domain"
+			}
+			t.Errorf("%s logged %s: %s", domain, logLevelName(level),
+				strings.TrimSpace(LogFieldValue(fields, "MESSAGE")))
+			return LOG_WRITER_HANDLED
+		}
+		if prev != nil {
+			return prev(level, fields)
+		}
+		return LOG_WRITER_UNHANDLED
+	})
+	t.Cleanup(func() {
+		LogSetWriterFunc(prev)
+	})
+}
+
+// logLevelName returns the name of the most severe level in level.
+func logLevelName(level LogLevelFlags) string {
+	switch {
+	case level&LOG_LEVEL_ERROR != 0:
+		return "ERROR"
+	case level&LOG_LEVEL_CRITICAL != 0:
+		return "CRITICAL"
+	case level&LOG_LEVEL_WARNING != 0:
+		return "WARNING"
+	case level&LOG_LEVEL_MESSAGE != 0:
+		return "MESSAGE"
+	case level&LOG_LEVEL_INFO != 0:
+		return "INFO"
+	case level&LOG_LEVEL_DEBUG != 0:
+		return "DEBUG"
+	}
+	return "LOG"
+}
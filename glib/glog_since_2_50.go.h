@@ -0,0 +1,33 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <glib.h>
+
+/*
+ * GLogWriterFunc
+ */
+
+extern GLogWriterOutput goLogWriterFunc (GLogLevelFlags log_level,
+										GLogField *fields,
+										gsize n_fields,
+										gpointer user_data);
+
+// _g_log_writer_func hands every structured message to Go and falls back to
+// the default writer for messages the Go writer leaves unhandled.
+static GLogWriterOutput _g_log_writer_func (GLogLevelFlags log_level,
+											const GLogField *fields,
+											gsize n_fields,
+											gpointer user_data) {
+	if (goLogWriterFunc(log_level, (GLogField *)(fields), n_fields, user_data) == G_LOG_WRITER_HANDLED)
+		return G_LOG_WRITER_HANDLED;
+	return g_log_writer_default(log_level, fields, n_fields, user_data);
+}
+
+static inline void _g_log_set_writer_func (void) {
+	g_log_set_writer_func(_g_log_writer_func, NULL, NULL);
+}
+
+static inline GLogField *_g_log_field_index (GLogField *fields, gsize i) {
+	return &fields[i];
+}
@@ -0,0 +1,72 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build go1.21,!glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// SetLogWriter routes all structured GLib log messages, including those
+// emitted by GTK, to h. The message becomes the record message and the
+// GLIB_DOMAIN, CODE_FILE, CODE_LINE and CODE_FUNC fields become the
+// "domain", "code_file", "code_line" and "code_func" attributes; any other
+// field is added under its own key. Messages below the level enabled by h
+// are left to GLib's default writer, which only prints the debug and info
+// messages of the domains listed in G_MESSAGES_DEBUG. Passing a nil handler
+// restores GLib's default writer.
+func SetLogWriter(h slog.Handler) {
+	if h == nil {
+		LogSetWriterFunc(nil)
+		return
+	}
+	LogSetWriterFunc(func(level LogLevelFlags, fields []LogField) LogWriterOutput {
+		ctx := context.Background()
+		slevel := LogLevelToSlog(level)
+		if !h.Enabled(ctx, slevel) {
+			return LOG_WRITER_UNHANDLED
+		}
+
+		r := slog.NewRecord(time.Now(), slevel, "", 0)
+		for _, f := range fields {
+			switch f.Key {
+			case "MESSAGE":
+				r.Message = strings.TrimSpace(f.Value)
+			case "GLIB_DOMAIN":
+				r.AddAttrs(slog.String("domain", f.Value))
+			case "CODE_FILE", "CODE_LINE", "CODE_FUNC":
+				r.AddAttrs(slog.String(strings.ToLower(f.Key), f.Value))
+			case "PRIORITY", "GLIB_OLD_LOG_API":
+				// Redundant with the record level.
+			default:
+				r.AddAttrs(slog.String(f.Key, f.Value))
+			}
+		}
+
+		if err := h.Handle(ctx, r); err != nil {
+			return LOG_WRITER_UNHANDLED
+		}
+		return LOG_WRITER_HANDLED
+	})
+}
+
+// LogLevelToSlog maps a GLib log level to a slog.Level. Errors map above
+// slog.LevelError, criticals to slog.LevelError, warnings to slog.LevelWarn,
+// messages and info to slog.LevelInfo and debug to slog.LevelDebug.
+func LogLevelToSlog(level LogLevelFlags) slog.Level {
+	switch {
+	case level&LOG_LEVEL_ERROR != 0:
+		return slog.LevelError + 4
+	case level&LOG_LEVEL_CRITICAL != 0:
+		return slog.LevelError
+	case level&LOG_LEVEL_WARNING != 0:
+		return slog.LevelWarn
+	case level&(LOG_LEVEL_MESSAGE|LOG_LEVEL_INFO) != 0:
+		return slog.LevelInfo
+	}
+	return slog.LevelDebug
+}
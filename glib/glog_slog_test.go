@@ -0,0 +1,70 @@
+// +build go1.21,!glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+type recordHandler struct {
+	min     slog.Level
+	records []slog.Record
+}
+
+func (h *recordHandler) Enabled(_ context.Context, l slog.Level) bool { return l >= h.min }
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler           { return h }
+func (h *recordHandler) WithGroup(string) slog.Handler                { return h }
+
+func (h *recordHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+
+func TestSetLogWriter(t *testing.T) {
+	h := &recordHandler{min: slog.LevelDebug}
+	glib.SetLogWriter(h)
+	defer glib.SetLogWriter(nil)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_WARNING, "something odd")
+
+	if len(h.records) != 1 {
+		t.Fatalf("expected one record, got %d", len(h.records))
+	}
+	r := h.records[0]
+	if r.Level != slog.LevelWarn {
+		t.Errorf("expected level %v, got %v", slog.LevelWarn, r.Level)
+	}
+	if r.Message != "something odd" {
+		t.Errorf("expected message %q, got %q", "something odd", r.Message)
+	}
+
+	var domain string
+	r.Attrs(func(a slog.Attr) bool {
+		if a.Key == "domain" {
+			domain = a.Value.String()
+		}
+		return true
+	})
+	if domain != "gotk3-test" {
+		t.Errorf("expected domain %q, got %q", "gotk3-test", domain)
+	}
+}
+
+func TestSetLogWriterDisabledLevel(t *testing.T) {
+	h := &recordHandler{min: slog.LevelWarn}
+	glib.SetLogWriter(h)
+	defer glib.SetLogWriter(nil)
+
+	// Left to the default writer, which does not print it unless the
+	// domain is listed in G_MESSAGES_DEBUG.
+	glib.Log("gotk3-test", glib.LOG_LEVEL_DEBUG, "too verbose")
+	glib.Log("gotk3-test", glib.LOG_LEVEL_WARNING, "something odd")
+
+	if len(h.records) != 1 || h.records[0].Message != "something odd" {
+		t.Errorf("expected only the warning to be handled, got %d records", len(h.records))
+	}
+}
@@ -0,0 +1,68 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+type fakeT struct {
+	errors  []string
+	cleanup []func()
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Cleanup(f func()) {
+	t.cleanup = append(t.cleanup, f)
+}
+
+func TestLogSetWriterFunc(t *testing.T) {
+	var got []glib.LogField
+	glib.LogSetWriterFunc(func(level glib.LogLevelFlags, fields []glib.LogField) glib.LogWriterOutput {
+		if level&glib.LOG_LEVEL_MESSAGE != 0 {
+			got = fields
+		}
+		return glib.LOG_WRITER_HANDLED
+	})
+	defer glib.LogSetWriterFunc(nil)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_MESSAGE, "hello %s")
+
+	if v := glib.LogFieldValue(got, "GLIB_DOMAIN"); v != "gotk3-test" {
+		t.Errorf("expected domain %q, got %q", "gotk3-test", v)
+	}
+	if v := glib.LogFieldValue(got, "MESSAGE"); v != "hello %s" {
+		t.Errorf("expected message %q, got %q", "hello %s", v)
+	}
+}
+
+func TestFailTestOnLog(t *testing.T) {
+	ft := &fakeT{}
+	glib.FailTestOnLog(ft, glib.LOG_LEVEL_CRITICAL)
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_MESSAGE, "not a failure")
+	if len(ft.errors) != 0 {
+		t.Errorf("expected no failures, got %v", ft.errors)
+	}
+
+	glib.Log("gotk3-test", glib.LOG_LEVEL_CRITICAL, "assertion failed")
+	if len(ft.errors) != 1 {
+		t.Fatalf("expected one failure, got %v", ft.errors)
+	}
+	if ft.errors[0] != "gotk3-test logged CRITICAL: assertion failed" {
+		t.Errorf("unexpected failure message %q", ft.errors[0])
+	}
+
+	for _, f := range ft.cleanup {
+		f()
+	}
+	glib.LogSetWriterFunc(nil)
+}
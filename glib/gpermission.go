@@ -7,7 +7,6 @@ package glib
 // #include "gpermission.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 )
 
@@ -66,8 +65,7 @@ func (v *Permission) Acquire(cancellable *Cancellable) error {
 	c := C.g_permission_acquire(v.native(), cancellable.native(), &err)
 	acquired := gobool(c)
 	if !acquired {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
@@ -86,8 +84,7 @@ func (v *Permission) AcquireFinish(result *AsyncResult) error {
 	c := C.g_permission_acquire_finish(v.native(), result.native(), &err)
 	acquired := gobool(c)
 	if !acquired {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
@@ -98,8 +95,7 @@ func (v *Permission) Release(cancellable *Cancellable) error {
 	c := C.g_permission_release(v.native(), cancellable.native(), &err)
 	released := gobool(c)
 	if !released {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
@@ -118,8 +114,7 @@ func (v *Permission) ReleaseFinish(result *AsyncResult) error {
 	c := C.g_permission_release_finish(v.native(), result.native(), &err)
 	released := gobool(c)
 	if !released {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return takeError(err)
 	}
 	return nil
 }
@@ -0,0 +1,127 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+import "C"
+import (
+	"errors"
+	"strings"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// MissingHandlersError is returned by Builder.ConnectSignals when signals of
+// the builder refer to handlers which are not in the map. The other signals
+// are connected all the same.
+type MissingHandlersError struct {
+	Handlers []string
+}
+
+func (e *MissingHandlersError) Error() string {
+	return "no handler for " + strings.Join(e.Handlers, ", ")
+}
+
+// AddObjectsFromFile is a wrapper around gtk_builder_add_objects_from_file().
+// Only the objects with the given ids, and the objects they depend on, are
+// built.
+func (b *Builder) AddObjectsFromFile(filename string, objectIDs []string) error {
+	cstr := C.CString(filename)
+	defer C.free(unsafe.Pointer(cstr))
+	cids := C.make_strings(C.int(len(objectIDs) + 1))
+	defer C.destroy_strings(cids)
+	for i, id := range objectIDs {
+		cid := C.CString(id)
+		defer C.free(unsafe.Pointer(cid))
+		C.set_string(cids, C.int(i), (*C.gchar)(cid))
+	}
+	C.set_string(cids, C.int(len(objectIDs)), nil)
+
+	var err *C.GError = nil
+	res := C.gtk_builder_add_objects_from_file(b.native(), (*C.gchar)(cstr), cids, &err)
+	if res == 0 {
+		return glib.TakeError(unsafe.Pointer(err))
+	}
+	return nil
+}
+
+// AddObjectsFromString is a wrapper around
+// gtk_builder_add_objects_from_string(). See AddObjectsFromFile.
+func (b *Builder) AddObjectsFromString(str string, objectIDs []string) error {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+	cids := C.make_strings(C.int(len(objectIDs) + 1))
+	defer C.destroy_strings(cids)
+	for i, id := range objectIDs {
+		cid := C.CString(id)
+		defer C.free(unsafe.Pointer(cid))
+		C.set_string(cids, C.int(i), (*C.gchar)(cid))
+	}
+	C.set_string(cids, C.int(len(objectIDs)), nil)
+
+	var err *C.GError = nil
+	res := C.gtk_builder_add_objects_from_string(b.native(), (*C.gchar)(cstr),
+		C.gsize(len(str)), cids, &err)
+	if res == 0 {
+		return glib.TakeError(unsafe.Pointer(err))
+	}
+	return nil
+}
+
+// GetObjects is a wrapper around gtk_builder_get_objects(). As with
+// GetObject, the objects need to be type-asserted before being used.
+func (b *Builder) GetObjects() ([]glib.IObject, error) {
+	clist := C.gtk_builder_get_objects(b.native())
+	defer C.g_slist_free(clist)
+
+	var objs []glib.IObject
+	for l := clist; l != nil; l = l.next {
+		obj, err := cast((*C.GObject)(l.data))
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// ValueFromString is a wrapper around gtk_builder_value_from_string(). It
+// parses str as the XML would for the property of obj.
+func (b *Builder) ValueFromString(obj *glib.Object, property, str string) (*glib.Value, error) {
+	cprop := C.CString(property)
+	defer C.free(unsafe.Pointer(cprop))
+	pspec := C.object_find_property(C.toGObject(unsafe.Pointer(obj.Native())), (*C.gchar)(cprop))
+	if pspec == nil {
+		return nil, errors.New("no property '" + property + "' on " + obj.TypeFromInstance().Name())
+	}
+
+	return b.valueFromString(str, func(cstr *C.gchar, v *C.GValue, err **C.GError) C.gboolean {
+		return C.gtk_builder_value_from_string(b.native(), pspec, cstr, v, err)
+	})
+}
+
+// ValueFromStringType is a wrapper around
+// gtk_builder_value_from_string_type(). It parses str as a value of type t.
+func (b *Builder) ValueFromStringType(t glib.Type, str string) (*glib.Value, error) {
+	return b.valueFromString(str, func(cstr *C.gchar, v *C.GValue, err **C.GError) C.gboolean {
+		return C.gtk_builder_value_from_string_type(b.native(), C.GType(t), cstr, v, err)
+	})
+}
+
+func (b *Builder) valueFromString(str string, parse func(*C.gchar, *C.GValue, **C.GError) C.gboolean) (*glib.Value, error) {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	// The value is initialized by GTK.
+	v, err := glib.ValueAlloc()
+	if err != nil {
+		return nil, err
+	}
+	var cerr *C.GError = nil
+	if parse((*C.gchar)(cstr), (*C.GValue)(unsafe.Pointer(v.Native())), &cerr) == 0 {
+		return nil, glib.TakeError(unsafe.Pointer(cerr))
+	}
+	return v, nil
+}
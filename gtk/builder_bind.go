@@ -0,0 +1,155 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// BindError is returned by Builder.Bind and lists all the fields which could
+// not be set and the handlers which could not be connected.
+type BindError struct {
+	Errors []error
+}
+
+func (e *BindError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors of e. From Go 1.20, errors.Is and errors.As
+// look through them; with older versions, they only see e itself, and the
+// errors have to be searched in the Errors field.
+func (e *BindError) Unwrap() []error {
+	return e.Errors
+}
+
+// Bind sets the fields of the struct pointed to by v to the objects of the
+// builder and connects its signals to the methods of v.
+//
+// Each exported field tagged with `gtk:"id"` is set to the object with that
+// id, which must be assignable to the field, such as a *Button field for a
+// GtkButton or an IWidget field for any widget. Untagged fields and fields
+// tagged with `gtk:"-"` are left alone.
+//
+// Signal handlers are looked up by name among the exported methods of v,
+// either verbatim or converted from snake case, so that a handler named
+// "on_ok_clicked" in the XML calls the OnOkClicked method.
+//
+// Bind sets every field and connects every handler it can, and returns a
+// *BindError listing the missing ids, type mismatches and missing handlers.
+func (b *Builder) Bind(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind expects a pointer to a struct, got %T", v)
+	}
+
+	errs := bindFields(rv.Elem(), b.GetObject)
+
+	handlers := make(map[string]interface{})
+	for i := 0; i < rv.NumMethod(); i++ {
+		m := rv.Type().Method(i)
+		handlers[m.Name] = rv.Method(i).Interface()
+	}
+	err := b.ConnectSignals(builderHandlers(handlers))
+	if missing, ok := err.(*MissingHandlersError); ok {
+		for _, h := range missing.Handlers {
+			errs = append(errs, fmt.Errorf("handler %s: no method %s", h, handlerMethodName(h)))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &BindError{errs}
+	}
+	return nil
+}
+
+// bindFields sets the exported fields of the struct s tagged with `gtk:"id"`
+// to the objects returned by lookup, and returns the errors of the fields
+// which could not be set.
+func bindFields(s reflect.Value, lookup func(id string) (glib.IObject, error)) []error {
+	var errs []error
+	for i := 0; i < s.NumField(); i++ {
+		field := s.Type().Field(i)
+		id := fieldID(field)
+		if id == "" {
+			continue
+		}
+
+		obj, err := lookup(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %v", field.Name, err))
+			continue
+		}
+		ov := reflect.ValueOf(obj)
+		if !ov.Type().AssignableTo(field.Type) {
+			errs = append(errs, fmt.Errorf("field %s: object '%s' is a %s, not a %s",
+				field.Name, id, ov.Type(), field.Type))
+			continue
+		}
+		s.Field(i).Set(ov)
+	}
+	return errs
+}
+
+// fieldID returns the id a field is tagged with, or "" if it is not bound.
+func fieldID(field reflect.StructField) string {
+	id := field.Tag.Get("gtk")
+	if id == "-" || field.PkgPath != "" {
+		return ""
+	}
+	return id
+}
+
+// builderHandlers adds the snake case name of each method to handlers.
+func builderHandlers(handlers map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, 2*len(handlers))
+	for name, h := range handlers {
+		m[name] = h
+		m[handlerSnakeName(name)] = h
+	}
+	return m
+}
+
+// handlerSnakeName converts a method name such as OnOkClicked to the
+// handler name on_ok_clicked. A run of capitals is a single word, so that
+// OnOKClicked is converted to on_ok_clicked as well.
+func handlerSnakeName(method string) string {
+	r := []rune(method)
+	var sb strings.Builder
+	for i := range r {
+		if unicode.IsUpper(r[i]) {
+			if i > 0 && (!unicode.IsUpper(r[i-1]) ||
+				i+1 < len(r) && unicode.IsLower(r[i+1])) {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(unicode.ToLower(r[i]))
+			continue
+		}
+		sb.WriteRune(r[i])
+	}
+	return sb.String()
+}
+
+// handlerMethodName converts a handler name such as on_ok_clicked to the
+// method name OnOkClicked.
+func handlerMethodName(handler string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(handler, "_") {
+		if part == "" {
+			continue
+		}
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		sb.WriteString(string(r))
+	}
+	return sb.String()
+}
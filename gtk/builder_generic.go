@@ -0,0 +1,29 @@
+//go:build go1.18
+// +build go1.18
+
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+import (
+	"fmt"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// GetObjectAs is like Builder.GetObject, but returns the object as a T, such
+// as *gtk.Window, or an error naming the actual type if it is not one:
+//
+//	win, err := gtk.GetObjectAs[*gtk.Window](builder, "window")
+func GetObjectAs[T glib.IObject](b *Builder, name string) (T, error) {
+	var zero T
+	obj, err := b.GetObject(name)
+	if err != nil {
+		return zero, err
+	}
+	t, ok := obj.(T)
+	if !ok {
+		return zero, fmt.Errorf("object '%s' is a %T, not a %T", name, obj, zero)
+	}
+	return t, nil
+}
@@ -0,0 +1,33 @@
+//go:build go1.18
+// +build go1.18
+
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+import "testing"
+
+func TestGetObjectAs(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddFromString(partialUI); err != nil {
+		t.Fatal(err)
+	}
+
+	label, err := GetObjectAs[*Label](b, "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label == nil {
+		t.Fatal("expected a label")
+	}
+
+	if _, err := GetObjectAs[*Label](b, "second"); err == nil {
+		t.Error("expected an error for a button")
+	}
+	if _, err := GetObjectAs[*Label](b, "missing"); err == nil {
+		t.Error("expected an error for a missing object")
+	}
+}
@@ -0,0 +1,24 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// ExposeObject is a wrapper around gtk_builder_expose_object(). It makes obj
+// available to the XML added afterwards under name, for instance as the
+// model of a view.
+func (b *Builder) ExposeObject(name string, obj *glib.Object) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_builder_expose_object(b.native(), (*C.gchar)(cstr),
+		(*C.GObject)(unsafe.Pointer(obj.Native())))
+}
@@ -0,0 +1,43 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6
+
+package gtk
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestBuilderExposeObject(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := ListStoreNew(glib.TYPE_STRING)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ExposeObject("exposed", store.Object)
+
+	err = b.AddFromString(`<interface>
+  <object class="GtkTreeView" id="view">
+    <property name="model">exposed</property>
+  </object>
+</interface>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := b.GetObject("view")
+	if err != nil {
+		t.Fatal(err)
+	}
+	model, err := obj.(*TreeView).GetModel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.ToTreeModel().Native() != store.Native() {
+		t.Error("expected the view to use the exposed store")
+	}
+}
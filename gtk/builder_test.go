@@ -0,0 +1,254 @@
+package gtk
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+const translatableLabelUI = `<interface>
+  <object class="GtkLabel" id="label">
+    <property name="label" translatable="yes">Hello</property>
+  </object>
+</interface>`
+
+// setenv sets the environment variable key to value for the duration of
+// the test.
+func setenv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestBuilderSetTranslationDomain(t *testing.T) {
+	// LANGUAGE is ignored by gettext under the plain "C" locale.
+	setenv(t, "LC_ALL", "C.UTF-8")
+	setenv(t, "LANGUAGE", "de")
+	glib.InitI18n("gotk3-builder", "testdata/locale")
+	// "messages" is the default domain of gettext.
+	defer glib.Textdomain("messages")
+	if glib.Local("Hello") != "Hallo" {
+		t.Skip("C.UTF-8 locale is not available")
+	}
+	// Make sure the builder does not fall back to the default domain.
+	glib.Textdomain("gotk3-other")
+
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetTranslationDomain("gotk3-builder")
+	if d := b.GetTranslationDomain(); d != "gotk3-builder" {
+		t.Errorf("expected translation domain %q, got %q", "gotk3-builder", d)
+	}
+
+	if err := b.AddFromString(translatableLabelUI); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := b.GetObject("label")
+	if err != nil {
+		t.Fatal(err)
+	}
+	label, ok := obj.(*Label)
+	if !ok {
+		t.Fatalf("expected *Label, got %T", obj)
+	}
+	if text, _ := label.GetText(); text != "Hallo" {
+		t.Errorf("expected translated label %q, got %q", "Hallo", text)
+	}
+}
+
+const partialUI = `<interface>
+  <object class="GtkListStore" id="store">
+    <columns>
+      <column type="gchararray"/>
+    </columns>
+  </object>
+  <object class="GtkLabel" id="first"/>
+  <object class="GtkButton" id="second">
+    <signal name="clicked" handler="on_second_clicked"/>
+  </object>
+</interface>`
+
+func TestBuilderAddObjectsFromString(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddObjectsFromString(partialUI, []string{"first"}); err != nil {
+		t.Fatal(err)
+	}
+	objs, err := b.GetObjects()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("expected only the requested object, got %d objects", len(objs))
+	}
+	if _, ok := objs[0].(*Label); !ok {
+		t.Errorf("expected *Label, got %T", objs[0])
+	}
+	if _, err := b.GetObject("second"); err == nil {
+		t.Error("expected the object not requested to be missing")
+	}
+}
+
+func TestBuilderValueFromString(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := b.ValueFromStringType(glib.TYPE_BOOLEAN, "yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gv, _ := v.GoValue(); gv != true {
+		t.Errorf("expected true, got %v", gv)
+	}
+	if _, err := b.ValueFromStringType(glib.TYPE_INT, "many"); err == nil {
+		t.Error("expected an error for an invalid integer")
+	}
+
+	label, err := LabelNew("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = b.ValueFromString(label.Object, "xalign", "0.25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gv, _ := v.GoValue(); gv != float32(0.25) {
+		t.Errorf("expected 0.25, got %v", gv)
+	}
+	if _, err := b.ValueFromString(label.Object, "no-such-property", "1"); err == nil {
+		t.Error("expected an error for an unknown property")
+	}
+}
+
+func TestBuilderConnectSignalsMissing(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddFromString(partialUI); err != nil {
+		t.Fatal(err)
+	}
+
+	err = b.ConnectSignals(map[string]interface{}{})
+	missing, ok := err.(*MissingHandlersError)
+	if !ok {
+		t.Fatalf("expected *MissingHandlersError, got %v", err)
+	}
+	if len(missing.Handlers) != 1 || missing.Handlers[0] != "on_second_clicked" {
+		t.Errorf("expected on_second_clicked to be missing, got %v", missing.Handlers)
+	}
+
+	// Signals are only connected once, so start over.
+	b, err = BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddFromString(partialUI); err != nil {
+		t.Fatal(err)
+	}
+	err = b.ConnectSignals(map[string]interface{}{
+		"on_second_clicked": func() {},
+	})
+	if err != nil {
+		t.Errorf("expected all handlers to be found, got %v", err)
+	}
+}
+
+type boundDialog struct {
+	Store  *ListStore `gtk:"store"`
+	First  IWidget    `gtk:"first"`
+	Second *Button    `gtk:"second"`
+	Other  *Label     `gtk:"-"`
+
+	clicked int
+}
+
+func (d *boundDialog) OnSecondClicked() {
+	d.clicked++
+}
+
+func TestBuilderBind(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddFromString(partialUI); err != nil {
+		t.Fatal(err)
+	}
+
+	var d boundDialog
+	if err := b.Bind(&d); err != nil {
+		t.Fatal(err)
+	}
+	if d.Store == nil || d.First == nil || d.Second == nil {
+		t.Fatalf("expected all tagged fields to be set, got %+v", d)
+	}
+	if _, ok := d.First.(*Label); !ok {
+		t.Errorf("expected *Label, got %T", d.First)
+	}
+
+	d.Second.Clicked()
+	if d.clicked != 1 {
+		t.Errorf("expected the handler to be called once, got %d", d.clicked)
+	}
+}
+
+func TestBuilderBindErrors(t *testing.T) {
+	b, err := BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddFromString(partialUI); err != nil {
+		t.Fatal(err)
+	}
+
+	var d struct {
+		First   *Button `gtk:"first"`
+		Missing *Label  `gtk:"missing"`
+	}
+	err = b.Bind(&d)
+	bindErr, ok := err.(*BindError)
+	if !ok {
+		t.Fatalf("expected *BindError, got %v", err)
+	}
+	// The type mismatch, the missing id and the missing handler.
+	if len(bindErr.Errors) != 3 {
+		t.Errorf("expected 3 errors, got %v", bindErr)
+	}
+	if d.First != nil {
+		t.Error("expected the mismatched field to be left alone")
+	}
+
+	if err := b.Bind(d); err == nil {
+		t.Error("expected an error for a struct which is not a pointer")
+	}
+}
+
+func TestHandlerSnakeName(t *testing.T) {
+	tests := map[string]string{
+		"Quit":            "quit",
+		"OnOkClicked":     "on_ok_clicked",
+		"OnOKClicked":     "on_ok_clicked",
+		"OnURL":           "on_url",
+		"HTTPServerStart": "http_server_start",
+		"OnItem2Activate": "on_item2_activate",
+	}
+	for method, expected := range tests {
+		if name := handlerSnakeName(method); name != expected {
+			t.Errorf("expected %q for %s, got %q", expected, method, name)
+		}
+	}
+}
@@ -352,8 +352,14 @@ func (v *CellArea) GetEditedCell() (ICellRenderer, error) {
 	return castCellRenderer(c)
 }
 
-// TODO:
-// gtk_cell_area_get_edit_widget // depends on GtkCellEditable
+// GetEditWidget is a wrapper around gtk_cell_area_get_edit_widget().
+func (v *CellArea) GetEditWidget() (*CellEditable, error) {
+	c := C.gtk_cell_area_get_edit_widget(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapCellEditable(glib.Take(unsafe.Pointer(c))), nil
+}
 
 // ActivateCell is a wrapper around gtk_cell_area_activate_cell().
 func (v *CellArea) ActivateCell(widget IWidget, renderer ICellRenderer,
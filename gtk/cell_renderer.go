@@ -0,0 +1,322 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.gtk_cell_renderer_accel_mode_get_type()), marshalCellRendererAccelMode},
+		{glib.Type(C.gtk_cell_renderer_mode_get_type()), marshalCellRendererMode},
+
+		// Objects/Interfaces
+		{glib.Type(C.gtk_cell_editable_get_type()), marshalCellEditable},
+		{glib.Type(C.gtk_cell_renderer_combo_get_type()), marshalCellRendererCombo},
+		{glib.Type(C.gtk_cell_renderer_spin_get_type()), marshalCellRendererSpin},
+		{glib.Type(C.gtk_cell_renderer_accel_get_type()), marshalCellRendererAccel},
+	}
+
+	glib.RegisterGValueMarshalers(tm)
+
+	WrapMap["GtkCellEditable"] = wrapCellEditable
+	WrapMap["GtkCellRendererCombo"] = wrapCellRendererCombo
+	WrapMap["GtkCellRendererSpin"] = wrapCellRendererSpin
+	WrapMap["GtkCellRendererAccel"] = wrapCellRendererAccel
+}
+
+// CellRendererAccelMode is a representation of GTK's GtkCellRendererAccelMode.
+type CellRendererAccelMode int
+
+const (
+	CELL_RENDERER_ACCEL_MODE_GTK   CellRendererAccelMode = C.GTK_CELL_RENDERER_ACCEL_MODE_GTK
+	CELL_RENDERER_ACCEL_MODE_OTHER CellRendererAccelMode = C.GTK_CELL_RENDERER_ACCEL_MODE_OTHER
+)
+
+func marshalCellRendererAccelMode(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return CellRendererAccelMode(c), nil
+}
+
+// CellRendererMode is a representation of GTK's GtkCellRendererMode.
+type CellRendererMode int
+
+const (
+	CELL_RENDERER_MODE_INERT       CellRendererMode = C.GTK_CELL_RENDERER_MODE_INERT
+	CELL_RENDERER_MODE_ACTIVATABLE CellRendererMode = C.GTK_CELL_RENDERER_MODE_ACTIVATABLE
+	CELL_RENDERER_MODE_EDITABLE    CellRendererMode = C.GTK_CELL_RENDERER_MODE_EDITABLE
+)
+
+func marshalCellRendererMode(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return CellRendererMode(c), nil
+}
+
+// cellPath converts the path of a cell renderer signal to a TreePath.
+func cellPath(path string) *TreePath {
+	p, err := TreePathNewFromString(path)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+/*
+ * GtkCellEditable
+ */
+
+// CellEditable is a representation of GTK's GtkCellEditable GInterface,
+// implemented by the widgets a cell renderer edits its cell with, such as
+// Entry, SpinButton and ComboBox.
+type CellEditable struct {
+	Widget
+}
+
+// ICellEditable is an interface type implemented by all structs
+// embedding a CellEditable, and by the widgets implementing GtkCellEditable:
+// Entry, SpinButton, ComboBox and ComboBoxText. It is meant to be used as an
+// argument type for wrapper functions that wrap around a C GTK function
+// taking a GtkCellEditable.
+type ICellEditable interface {
+	toCellEditable() *C.GtkCellEditable
+}
+
+// native returns a pointer to the underlying GObject as a GtkCellEditable.
+func (v *CellEditable) native() *C.GtkCellEditable {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkCellEditable(p)
+}
+
+func (v *CellEditable) toCellEditable() *C.GtkCellEditable {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+func marshalCellEditable(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellEditable(obj), nil
+}
+
+func wrapCellEditable(obj *glib.Object) *CellEditable {
+	return &CellEditable{Widget{glib.InitiallyUnowned{obj}}}
+}
+
+// StartEditing is a wrapper around gtk_cell_editable_start_editing().
+func (v *CellEditable) StartEditing(event *gdk.Event) {
+	var e *C.GdkEvent
+	if event != nil {
+		e = (*C.GdkEvent)(unsafe.Pointer(event.Native()))
+	}
+	C.gtk_cell_editable_start_editing(v.native(), e)
+}
+
+// EditingDone is a wrapper around gtk_cell_editable_editing_done().
+func (v *CellEditable) EditingDone() {
+	C.gtk_cell_editable_editing_done(v.native())
+}
+
+// RemoveWidget is a wrapper around gtk_cell_editable_remove_widget().
+func (v *CellEditable) RemoveWidget() {
+	C.gtk_cell_editable_remove_widget(v.native())
+}
+
+// GetWidget returns the editable as the widget implementing it, such as an
+// *Entry.
+func (v *CellEditable) GetWidget() (IWidget, error) {
+	return castWidget(v.Widget.native())
+}
+
+/*
+ * GtkCellRendererCombo
+ */
+
+// CellRendererCombo is a representation of GTK's GtkCellRendererCombo.
+type CellRendererCombo struct {
+	CellRendererText
+}
+
+// native returns a pointer to the underlying GtkCellRendererCombo.
+func (v *CellRendererCombo) native() *C.GtkCellRendererCombo {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkCellRendererCombo(p)
+}
+
+func marshalCellRendererCombo(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellRendererCombo(obj), nil
+}
+
+func wrapCellRendererCombo(obj *glib.Object) *CellRendererCombo {
+	return &CellRendererCombo{CellRendererText{CellRenderer{glib.InitiallyUnowned{obj}}}}
+}
+
+// CellRendererComboNew is a wrapper around gtk_cell_renderer_combo_new().
+// The choices are set with the "model" and "text-column" properties.
+func CellRendererComboNew() (*CellRendererCombo, error) {
+	c := C.gtk_cell_renderer_combo_new()
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellRendererCombo(obj), nil
+}
+
+/*
+ * GtkCellRendererSpin
+ */
+
+// CellRendererSpin is a representation of GTK's GtkCellRendererSpin.
+type CellRendererSpin struct {
+	CellRendererText
+}
+
+// native returns a pointer to the underlying GtkCellRendererSpin.
+func (v *CellRendererSpin) native() *C.GtkCellRendererSpin {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkCellRendererSpin(p)
+}
+
+func marshalCellRendererSpin(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellRendererSpin(obj), nil
+}
+
+func wrapCellRendererSpin(obj *glib.Object) *CellRendererSpin {
+	return &CellRendererSpin{CellRendererText{CellRenderer{glib.InitiallyUnowned{obj}}}}
+}
+
+// CellRendererSpinNew is a wrapper around gtk_cell_renderer_spin_new().
+// The range is set with the "adjustment" property.
+func CellRendererSpinNew() (*CellRendererSpin, error) {
+	c := C.gtk_cell_renderer_spin_new()
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellRendererSpin(obj), nil
+}
+
+/*
+ * GtkCellRendererAccel
+ */
+
+// CellRendererAccel is a representation of GTK's GtkCellRendererAccel.
+type CellRendererAccel struct {
+	CellRendererText
+}
+
+// native returns a pointer to the underlying GtkCellRendererAccel.
+func (v *CellRendererAccel) native() *C.GtkCellRendererAccel {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkCellRendererAccel(p)
+}
+
+func marshalCellRendererAccel(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellRendererAccel(obj), nil
+}
+
+func wrapCellRendererAccel(obj *glib.Object) *CellRendererAccel {
+	return &CellRendererAccel{CellRendererText{CellRenderer{glib.InitiallyUnowned{obj}}}}
+}
+
+// CellRendererAccelNew is a wrapper around gtk_cell_renderer_accel_new().
+func CellRendererAccelNew() (*CellRendererAccel, error) {
+	c := C.gtk_cell_renderer_accel_new()
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapCellRendererAccel(obj), nil
+}
+
+/*
+ * Cell renderer properties and signals
+ */
+
+// SetMode sets the "mode" property of the renderer, which tells whether
+// its cells can be activated or edited.
+func (v *CellRenderer) SetMode(mode CellRendererMode) {
+	C._gtk_cell_renderer_set_mode(v.native(), C.GtkCellRendererMode(mode))
+}
+
+// GetMode returns the "mode" property of the renderer.
+func (v *CellRenderer) GetMode() CellRendererMode {
+	return CellRendererMode(C._gtk_cell_renderer_get_mode(v.native()))
+}
+
+// ConnectEditingStarted connects f to the "editing-started" signal, emitted
+// when editable starts editing the cell at path. f may customize editable,
+// for instance by adding a completion to the *Entry of a text cell.
+func (v *CellRenderer) ConnectEditingStarted(f func(editable *CellEditable, path *TreePath)) (glib.SignalHandle, error) {
+	return v.Connect("editing-started", func(_ interface{}, editable *CellEditable, path string) {
+		f(editable, cellPath(path))
+	})
+}
+
+// ConnectEditingCanceled connects f to the "editing-canceled" signal,
+// emitted when the user cancels editing the cell.
+func (v *CellRenderer) ConnectEditingCanceled(f func()) (glib.SignalHandle, error) {
+	return v.Connect("editing-canceled", func(_ interface{}) {
+		f()
+	})
+}
+
+// ConnectEdited connects f to the "edited" signal, emitted when the user
+// changed the text of the cell at path to newText. f is expected to store
+// newText in the model.
+func (v *CellRendererText) ConnectEdited(f func(path *TreePath, newText string)) (glib.SignalHandle, error) {
+	return v.Connect("edited", func(_ interface{}, path, newText string) {
+		f(cellPath(path), newText)
+	})
+}
+
+// ConnectToggled connects f to the "toggled" signal, emitted when the user
+// toggles the cell at path. f is expected to update the model.
+func (v *CellRendererToggle) ConnectToggled(f func(path *TreePath)) (glib.SignalHandle, error) {
+	return v.Connect("toggled", func(_ interface{}, path string) {
+		f(cellPath(path))
+	})
+}
+
+// ConnectAccelEdited connects f to the "accel-edited" signal, emitted when
+// the user selects a new accelerator for the cell at path.
+func (v *CellRendererAccel) ConnectAccelEdited(f func(path *TreePath, key uint, mods gdk.ModifierType, hardwareKeycode uint)) (glib.SignalHandle, error) {
+	return v.Connect("accel-edited", func(_ interface{}, path string, key uint, mods gdk.ModifierType, hardwareKeycode uint) {
+		f(cellPath(path), key, mods, hardwareKeycode)
+	})
+}
+
+// ConnectAccelCleared connects f to the "accel-cleared" signal, emitted when
+// the user removes the accelerator of the cell at path.
+func (v *CellRendererAccel) ConnectAccelCleared(f func(path *TreePath)) (glib.SignalHandle, error) {
+	return v.Connect("accel-cleared", func(_ interface{}, path string) {
+		f(cellPath(path))
+	})
+}
@@ -0,0 +1,108 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "cell_renderer_impl.go.h"
+import "C"
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	WrapMap["GotkCellRenderer"] = wrapGoCellRenderer
+}
+
+/*
+ * Go-implemented GtkCellRenderer
+ */
+
+// CellRendererImplementation sizes and draws the cells of a GoCellRenderer.
+// Its methods mirror the virtual functions of GtkCellRendererClass.
+//
+// The renderer has no properties of its own to map model columns to, so the
+// data of the row being drawn is usually handed to the implementation by a
+// cell data function, see TreeViewColumn.SetCellDataFunc and
+// CellLayout.SetCellDataFunc. The "xpad", "ypad", "xalign" and "yalign"
+// properties of the renderer are left for the implementation to honour.
+type CellRendererImplementation interface {
+	GetPreferredWidth(widget *Widget) (minimum, natural int)
+	GetPreferredHeight(widget *Widget) (minimum, natural int)
+	Render(cr *cairo.Context, widget *Widget, backgroundArea, cellArea *gdk.Rectangle, flags CellRendererState)
+}
+
+// CellRendererActivateImplementation may be implemented by a
+// CellRendererImplementation whose cells react to clicks, like toggles. The
+// renderer mode must be set to CELL_RENDERER_MODE_ACTIVATABLE with SetMode.
+// Activate returns whether the event was handled.
+type CellRendererActivateImplementation interface {
+	Activate(event *gdk.Event, widget *Widget, path string, backgroundArea, cellArea *gdk.Rectangle, flags CellRendererState) bool
+}
+
+// CellRendererStartEditingImplementation may be implemented by a
+// CellRendererImplementation whose cells are edited with a widget, such as
+// an Entry. The renderer mode must be set to CELL_RENDERER_MODE_EDITABLE with
+// SetMode. StartEditing returns the widget editing the cell at path, or nil
+// if it cannot be edited.
+type CellRendererStartEditingImplementation interface {
+	StartEditing(event *gdk.Event, widget *Widget, path string, backgroundArea, cellArea *gdk.Rectangle, flags CellRendererState) ICellEditable
+}
+
+var (
+	goCellRendererRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]CellRendererImplementation
+	}{
+		next: 1,
+		m:    make(map[int]CellRendererImplementation),
+	}
+)
+
+// GoCellRenderer is a GtkCellRenderer implemented in Go. Like the other
+// renderers, it is packed into a TreeViewColumn, a CellArea or any other
+// CellLayout.
+type GoCellRenderer struct {
+	CellRenderer
+}
+
+func wrapGoCellRenderer(obj *glib.Object) *GoCellRenderer {
+	return &GoCellRenderer{CellRenderer{glib.InitiallyUnowned{obj}}}
+}
+
+// GoCellRendererNew creates a GoCellRenderer drawing its cells with impl.
+func GoCellRendererNew(impl CellRendererImplementation) (*GoCellRenderer, error) {
+	if impl == nil {
+		return nil, errors.New("impl is nil")
+	}
+
+	goCellRendererRegistry.Lock()
+	id := goCellRendererRegistry.next
+	goCellRendererRegistry.next++
+	goCellRendererRegistry.m[id] = impl
+	goCellRendererRegistry.Unlock()
+
+	c := C._gotk_cell_renderer_new(C.guint(id))
+	if c == nil {
+		goCellRendererRegistry.Lock()
+		delete(goCellRendererRegistry.m, id)
+		goCellRendererRegistry.Unlock()
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGoCellRenderer(obj), nil
+}
+
+// goCellRendererImplementation returns the implementation registered as id.
+func goCellRendererImplementation(id C.guint) CellRendererImplementation {
+	goCellRendererRegistry.RLock()
+	defer goCellRendererRegistry.RUnlock()
+	return goCellRendererRegistry.m[int(id)]
+}
@@ -0,0 +1,89 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gtk/gtk.h>
+
+/*
+ * GotkCellRenderer is a GtkCellRenderer whose size, drawing and editing are
+ * provided by Go. The id is the handle of the Go implementation in
+ * goCellRendererRegistry.
+ */
+
+extern void goCellRendererGetPreferredWidth (guint id, GtkWidget *widget, gint *minimum_size, gint *natural_size);
+extern void goCellRendererGetPreferredHeight (guint id, GtkWidget *widget, gint *minimum_size, gint *natural_size);
+extern void goCellRendererRender (guint id, cairo_t *cr, GtkWidget *widget, GdkRectangle *background_area,
+	GdkRectangle *cell_area, GtkCellRendererState flags);
+extern gboolean goCellRendererActivate (guint id, GdkEvent *event, GtkWidget *widget, gchar *path,
+	GdkRectangle *background_area, GdkRectangle *cell_area, GtkCellRendererState flags);
+extern GtkCellEditable *goCellRendererStartEditing (guint id, GdkEvent *event, GtkWidget *widget, gchar *path,
+	GdkRectangle *background_area, GdkRectangle *cell_area, GtkCellRendererState flags);
+extern void goCellRendererFinalize (guint id);
+
+typedef struct {
+	GtkCellRenderer parent_instance;
+	guint id;
+} GotkCellRenderer;
+
+typedef struct {
+	GtkCellRendererClass parent_class;
+} GotkCellRendererClass;
+
+G_DEFINE_TYPE (GotkCellRenderer, gotk_cell_renderer, GTK_TYPE_CELL_RENDERER)
+
+#define GOTK_CELL_RENDERER(r) ((GotkCellRenderer *)(r))
+
+static void gotk_cell_renderer_get_preferred_width (GtkCellRenderer *cell, GtkWidget *widget,
+	gint *minimum_size, gint *natural_size) {
+	goCellRendererGetPreferredWidth(GOTK_CELL_RENDERER(cell)->id, widget, minimum_size, natural_size);
+}
+
+static void gotk_cell_renderer_get_preferred_height (GtkCellRenderer *cell, GtkWidget *widget,
+	gint *minimum_size, gint *natural_size) {
+	goCellRendererGetPreferredHeight(GOTK_CELL_RENDERER(cell)->id, widget, minimum_size, natural_size);
+}
+
+static void gotk_cell_renderer_render (GtkCellRenderer *cell, cairo_t *cr, GtkWidget *widget,
+	const GdkRectangle *background_area, const GdkRectangle *cell_area, GtkCellRendererState flags) {
+	goCellRendererRender(GOTK_CELL_RENDERER(cell)->id, cr, widget,
+		(GdkRectangle *)background_area, (GdkRectangle *)cell_area, flags);
+}
+
+static gboolean gotk_cell_renderer_activate (GtkCellRenderer *cell, GdkEvent *event, GtkWidget *widget,
+	const gchar *path, const GdkRectangle *background_area, const GdkRectangle *cell_area,
+	GtkCellRendererState flags) {
+	return goCellRendererActivate(GOTK_CELL_RENDERER(cell)->id, event, widget, (gchar *)path,
+		(GdkRectangle *)background_area, (GdkRectangle *)cell_area, flags);
+}
+
+static GtkCellEditable *gotk_cell_renderer_start_editing (GtkCellRenderer *cell, GdkEvent *event,
+	GtkWidget *widget, const gchar *path, const GdkRectangle *background_area,
+	const GdkRectangle *cell_area, GtkCellRendererState flags) {
+	return goCellRendererStartEditing(GOTK_CELL_RENDERER(cell)->id, event, widget, (gchar *)path,
+		(GdkRectangle *)background_area, (GdkRectangle *)cell_area, flags);
+}
+
+static void gotk_cell_renderer_finalize (GObject *object) {
+	goCellRendererFinalize(GOTK_CELL_RENDERER(object)->id);
+	G_OBJECT_CLASS(gotk_cell_renderer_parent_class)->finalize(object);
+}
+
+static void gotk_cell_renderer_class_init (GotkCellRendererClass *klass) {
+	GtkCellRendererClass *cell_class = GTK_CELL_RENDERER_CLASS(klass);
+
+	G_OBJECT_CLASS(klass)->finalize = gotk_cell_renderer_finalize;
+	cell_class->get_preferred_width = gotk_cell_renderer_get_preferred_width;
+	cell_class->get_preferred_height = gotk_cell_renderer_get_preferred_height;
+	cell_class->render = gotk_cell_renderer_render;
+	cell_class->activate = gotk_cell_renderer_activate;
+	cell_class->start_editing = gotk_cell_renderer_start_editing;
+}
+
+static void gotk_cell_renderer_init (GotkCellRenderer *self) {
+}
+
+static inline GtkCellRenderer *_gotk_cell_renderer_new (guint id) {
+	GotkCellRenderer *cell = g_object_new(gotk_cell_renderer_get_type(), NULL);
+	cell->id = id;
+	return GTK_CELL_RENDERER(cell);
+}
@@ -0,0 +1,250 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// barRenderer draws a horizontal bar filling value of the cell width.
+type barRenderer struct {
+	value     float64
+	rendered  []int
+	activated string
+}
+
+func (r *barRenderer) GetPreferredWidth(widget *gtk.Widget) (int, int) {
+	return 40, 100
+}
+
+func (r *barRenderer) GetPreferredHeight(widget *gtk.Widget) (int, int) {
+	return 10, 12
+}
+
+func (r *barRenderer) Render(cr *cairo.Context, widget *gtk.Widget, backgroundArea, cellArea *gdk.Rectangle, flags gtk.CellRendererState) {
+	width := int(r.value * float64(cellArea.GetWidth()))
+	cr.SetSourceRGB(1, 0, 0)
+	cr.Rectangle(float64(cellArea.GetX()), float64(cellArea.GetY()), float64(width), float64(cellArea.GetHeight()))
+	cr.Fill()
+	r.rendered = append(r.rendered, width)
+}
+
+func (r *barRenderer) Activate(event *gdk.Event, widget *gtk.Widget, path string, backgroundArea, cellArea *gdk.Rectangle, flags gtk.CellRendererState) bool {
+	r.activated = path
+	return true
+}
+
+// entryRenderer is a barRenderer whose cells are edited with an entry.
+type entryRenderer struct {
+	barRenderer
+	parent  *gtk.Box
+	entry   *gtk.Entry
+	editing string
+}
+
+func (r *entryRenderer) StartEditing(event *gdk.Event, widget *gtk.Widget, path string, backgroundArea, cellArea *gdk.Rectangle, flags gtk.CellRendererState) gtk.ICellEditable {
+	r.editing = path
+	// The cell area only starts editing with a widget which was given a
+	// parent, usually by the handler of its "add-editable" signal.
+	r.parent.Add(r.entry)
+	return r.entry
+}
+
+func cellRect(width, height int) *gdk.Rectangle {
+	var rect gdk.Rectangle
+	rect.SetWidth(width)
+	rect.SetHeight(height)
+	return &rect
+}
+
+func TestGoCellRendererInCellArea(t *testing.T) {
+	impl := &barRenderer{value: 0.5}
+	renderer, err := gtk.GoCellRendererNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	area, err := gtk.CellAreaBoxNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	area.Add(renderer)
+	if !area.HasRenderer(renderer) {
+		t.Fatal("expected the area to hold the Go renderer")
+	}
+
+	widget, err := gtk.LabelNew("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	context, err := area.CreateContext()
+	if err != nil {
+		t.Fatal(err)
+	}
+	min, nat := area.GetPreferredWidth(context, widget)
+	if min != 40 || nat != 100 {
+		t.Errorf("expected the preferred width of the renderer (40, 100), got (%d, %d)", min, nat)
+	}
+	context.Allocate(80, 12)
+
+	surface := cairo.CreateImageSurface(cairo.FORMAT_ARGB32, 80, 12)
+	cr := cairo.Create(surface)
+	area.Render(context, widget, cr, cellRect(80, 12), cellRect(80, 12), 0, false)
+	if len(impl.rendered) != 1 || impl.rendered[0] != 40 {
+		t.Errorf("expected a single render filling half the cell, got %v", impl.rendered)
+	}
+
+	if area.IsActivatable() {
+		t.Error("expected an inert renderer not to be activatable")
+	}
+	renderer.SetMode(gtk.CELL_RENDERER_MODE_ACTIVATABLE)
+	if renderer.GetMode() != gtk.CELL_RENDERER_MODE_ACTIVATABLE {
+		t.Fatal("expected the renderer to be activatable")
+	}
+	if !area.IsActivatable() {
+		t.Error("expected the area to be activatable")
+	}
+}
+
+func TestGoCellRendererInTreeView(t *testing.T) {
+	impl := &barRenderer{value: 1}
+	renderer, err := gtk.GoCellRendererNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := gtk.TreeViewColumnNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	column.PackStart(renderer, true)
+
+	store, err := gtk.ListStoreNew(glib.TYPE_DOUBLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetValue(store.Append(), 0, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	column.SetCellDataFunc(renderer, func(_ *gtk.TreeViewColumn, _ *gtk.CellRenderer, model *gtk.TreeModel, iter *gtk.TreeIter) {
+		v, err := model.GetValue(iter, 0)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		value, _ := v.GoValue()
+		impl.value, _ = value.(float64)
+	})
+	view, err := gtk.TreeViewNewWithModel(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	view.AppendColumn(column)
+
+	renderOffscreen(t, view, func() bool { return len(impl.rendered) > 0 })
+	if len(impl.rendered) == 0 {
+		t.Fatal("expected the tree view to render the Go cell")
+	}
+	if impl.value != 0.25 {
+		t.Errorf("expected the value of the row to be bound to the renderer, got %v", impl.value)
+	}
+}
+
+// cellAreaWithRow returns a cell area holding renderer, set on the single
+// row of a model, and a widget to activate its cells on.
+func cellAreaWithRow(t *testing.T, renderer gtk.ICellRenderer) (*gtk.CellAreaBox, *gtk.Widget) {
+	area, err := gtk.CellAreaBoxNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	area.Add(renderer)
+	area.SetFocusCell(renderer)
+
+	store, err := gtk.ListStoreNew(glib.TYPE_DOUBLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iter := store.Append()
+	area.ApplyAttributes(store, iter, false, false)
+	if path := area.GetCurrentPathString(); path != "0" {
+		t.Fatalf("expected the area to be set on row 0, got %q", path)
+	}
+
+	label, err := gtk.LabelNew("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return area, &label.Widget
+}
+
+func TestGoCellRendererActivate(t *testing.T) {
+	impl := &barRenderer{}
+	renderer, err := gtk.GoCellRendererNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetMode(gtk.CELL_RENDERER_MODE_ACTIVATABLE)
+	area, widget := cellAreaWithRow(t, renderer)
+
+	event := gdk.EventNew(gdk.EVENT_BUTTON_PRESS)
+	if !area.ActivateCell(widget, renderer, event, cellRect(80, 12), 0) {
+		t.Error("expected the activation to be handled by the renderer")
+	}
+	if impl.activated != "0" {
+		t.Errorf("expected the cell at path 0 to be activated, got %q", impl.activated)
+	}
+}
+
+func TestGoCellRendererStartEditing(t *testing.T) {
+	impl := &entryRenderer{}
+	var err error
+	if impl.parent, err = gtk.BoxNew(gtk.ORIENTATION_VERTICAL, 0); err != nil {
+		t.Fatal(err)
+	}
+	if impl.entry, err = gtk.EntryNew(); err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := gtk.GoCellRendererNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetMode(gtk.CELL_RENDERER_MODE_EDITABLE)
+	area, widget := cellAreaWithRow(t, renderer)
+
+	event := gdk.EventNew(gdk.EVENT_BUTTON_PRESS)
+	if !area.ActivateCell(widget, renderer, event, cellRect(80, 12), 0) {
+		t.Fatal("expected the activation to start editing")
+	}
+	if impl.editing != "0" {
+		t.Errorf("expected the cell at path 0 to be edited, got %q", impl.editing)
+	}
+	editable, err := area.GetEditWidget()
+	if err != nil {
+		t.Fatal("expected an edit widget:", err)
+	}
+	if editable.Native() != impl.entry.Widget.Native() {
+		t.Error("expected the entry returned by StartEditing to be the edit widget")
+	}
+	edited, err := area.GetEditedCell()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, ok := edited.(*gtk.GoCellRenderer); !ok || r.Native() != renderer.Native() {
+		t.Error("expected the Go renderer to be the edited cell")
+	}
+
+	area.StopEditing(true)
+	if _, err := area.GetEditWidget(); err == nil {
+		t.Error("expected no edit widget once editing is stopped")
+	}
+}
+
+func TestGoCellRendererNilImplementation(t *testing.T) {
+	if _, err := gtk.GoCellRendererNew(nil); err == nil {
+		t.Error("expected an error for a nil implementation")
+	}
+}
@@ -0,0 +1,139 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestCellRendererTextEditing(t *testing.T) {
+	store, err := gtk.ListStoreNew(glib.TYPE_STRING)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetValue(store.Append(), 0, "before"); err != nil {
+		t.Fatal(err)
+	}
+
+	view, err := gtk.TreeViewNewWithModel(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := gtk.CellRendererTextNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetProperty("editable", true)
+	column, err := gtk.TreeViewColumnNewWithAttribute("Text", renderer, "text", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	view.AppendColumn(column)
+
+	var started *gtk.CellEditable
+	renderer.ConnectEditingStarted(func(editable *gtk.CellEditable, path *gtk.TreePath) {
+		if path.String() != "0" {
+			t.Errorf("expected editing to start on row 0, got %s", path)
+		}
+		started = editable
+	})
+	renderer.ConnectEdited(func(path *gtk.TreePath, newText string) {
+		iter, err := store.GetIter(path)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		store.SetValue(iter, 0, newText)
+	})
+
+	win, err := gtk.OffscreenWindowNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer win.Destroy()
+	win.Add(view)
+	win.ShowAll()
+	for gtk.EventsPending() {
+		gtk.MainIteration()
+	}
+
+	path, err := gtk.TreePathNewFromString("0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	view.SetCursor(path, column, true)
+	if started == nil {
+		t.Fatal("expected editing to start")
+	}
+	w, err := started.GetWidget()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, ok := w.(*gtk.Entry)
+	if !ok {
+		t.Fatalf("expected a *gtk.Entry editable, got %T", w)
+	}
+	entry.SetText("after")
+	started.EditingDone()
+
+	iter, ok := store.GetIterFirst()
+	if !ok {
+		t.Fatal("expected a first row")
+	}
+	v, _ := store.GetValue(iter, 0)
+	if s, _ := v.GetString(); s != "after" {
+		t.Errorf("expected the edited text in the model, got %q", s)
+	}
+}
+
+func TestCellRendererToggleToggled(t *testing.T) {
+	renderer, err := gtk.CellRendererToggleNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var toggled string
+	renderer.ConnectToggled(func(path *gtk.TreePath) {
+		toggled = path.String()
+	})
+	renderer.Emit("toggled", "1:2")
+	if toggled != "1:2" {
+		t.Errorf("expected path 1:2, got %q", toggled)
+	}
+}
+
+func TestEditableCellRenderers(t *testing.T) {
+	combo, err := gtk.CellRendererComboNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := combo.GetProperty("has-entry"); has != true {
+		t.Error("expected a combo renderer with an entry by default")
+	}
+
+	spin, err := gtk.CellRendererSpinNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	adj, err := gtk.AdjustmentNew(5, 0, 10, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := spin.SetProperty("adjustment", adj.Object); err != nil {
+		t.Error(err)
+	}
+
+	accel, err := gtk.CellRendererAccelNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mode, err := accel.GetProperty("accel-mode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode != gtk.CELL_RENDERER_ACCEL_MODE_GTK {
+		t.Errorf("expected CELL_RENDERER_ACCEL_MODE_GTK, got %v", mode)
+	}
+}
@@ -0,0 +1,270 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "clipboard.go.h"
+import "C"
+import (
+	"context"
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+)
+
+/*
+ * Asynchronous GtkClipboard requests
+ */
+
+// ClipboardTextReceivedFunc is called by Clipboard.RequestText with the
+// text of the clipboard, and false if the clipboard holds no text.
+type ClipboardTextReceivedFunc func(clipboard *Clipboard, text string, ok bool)
+
+// ClipboardImageReceivedFunc is called by Clipboard.RequestImage with the
+// image of the clipboard, or nil if it holds no image.
+type ClipboardImageReceivedFunc func(clipboard *Clipboard, pixbuf *gdk.Pixbuf)
+
+// ClipboardURIReceivedFunc is called by Clipboard.RequestURIs with the URIs
+// of the clipboard, or nil if it holds no URI list.
+type ClipboardURIReceivedFunc func(clipboard *Clipboard, uris []string)
+
+// ClipboardReceivedFunc is called by Clipboard.RequestContents with the
+// contents of the clipboard. The length of data is negative if the
+// retrieval failed. data is only valid during the call, see
+// SelectionData.Copy.
+type ClipboardReceivedFunc func(clipboard *Clipboard, data *SelectionData)
+
+// ClipboardTargetsReceivedFunc is called by Clipboard.RequestTargets with
+// the targets offered by the owner of the clipboard, or nil if the
+// retrieval failed.
+type ClipboardTargetsReceivedFunc func(clipboard *Clipboard, targets []gdk.Atom)
+
+var (
+	// clipboardRequestRegistry holds the callbacks of pending requests,
+	// each one of the Clipboard*ReceivedFunc types. They are removed once
+	// called.
+	clipboardRequestRegistry = struct {
+		sync.Mutex
+		next int
+		m    map[int]interface{}
+	}{
+		next: 1,
+		m:    make(map[int]interface{}),
+	}
+)
+
+func registerClipboardRequest(f interface{}) C.gpointer {
+	clipboardRequestRegistry.Lock()
+	id := clipboardRequestRegistry.next
+	clipboardRequestRegistry.next++
+	clipboardRequestRegistry.m[id] = f
+	clipboardRequestRegistry.Unlock()
+	return C.gpointer(uintptr(id))
+}
+
+// takeClipboardRequest removes and returns the callback of a request.
+func takeClipboardRequest(data C.gpointer) interface{} {
+	id := int(uintptr(data))
+	clipboardRequestRegistry.Lock()
+	defer clipboardRequestRegistry.Unlock()
+	f := clipboardRequestRegistry.m[id]
+	delete(clipboardRequestRegistry.m, id)
+	return f
+}
+
+// RequestText is a wrapper around gtk_clipboard_request_text(). Unlike
+// WaitForText, it returns immediately and f is called from the main loop
+// once the text has been retrieved.
+func (v *Clipboard) RequestText(f ClipboardTextReceivedFunc) {
+	C._gtk_clipboard_request_text(v.native(), registerClipboardRequest(f))
+}
+
+// RequestImage is a wrapper around gtk_clipboard_request_image().
+func (v *Clipboard) RequestImage(f ClipboardImageReceivedFunc) {
+	C._gtk_clipboard_request_image(v.native(), registerClipboardRequest(f))
+}
+
+// RequestURIs is a wrapper around gtk_clipboard_request_uris().
+func (v *Clipboard) RequestURIs(f ClipboardURIReceivedFunc) {
+	C._gtk_clipboard_request_uris(v.native(), registerClipboardRequest(f))
+}
+
+// RequestContents is a wrapper around gtk_clipboard_request_contents().
+func (v *Clipboard) RequestContents(target gdk.Atom, f ClipboardReceivedFunc) {
+	C._gtk_clipboard_request_contents(v.native(), C.GdkAtom(unsafe.Pointer(target)),
+		registerClipboardRequest(f))
+}
+
+// RequestTargets is a wrapper around gtk_clipboard_request_targets().
+func (v *Clipboard) RequestTargets(f ClipboardTargetsReceivedFunc) {
+	C._gtk_clipboard_request_targets(v.native(), registerClipboardRequest(f))
+}
+
+// WaitForURIs is a wrapper around gtk_clipboard_wait_for_uris().
+func (v *Clipboard) WaitForURIs() []string {
+	c := C.gtk_clipboard_wait_for_uris(v.native())
+	if c == nil {
+		return nil
+	}
+	return toGoStringArray(c)
+}
+
+/*
+ * Channel-based GtkClipboard requests
+ */
+
+// ClipboardText is the result of Clipboard.ReadText.
+type ClipboardText struct {
+	Text string
+	Err  error
+}
+
+// ClipboardContents is the result of Clipboard.ReadContents. Data is a
+// copy of the selection data and may be kept.
+type ClipboardContents struct {
+	Data *SelectionData
+	Err  error
+}
+
+var errClipboardNoData = errors.New("clipboard has no data for the requested target")
+
+// ReadText requests the text of the clipboard and returns a channel
+// receiving it, or an error if the clipboard holds no text or ctx is done
+// first. The channel receives a single value and is then closed.
+//
+// Like RequestText, ReadText must be called from the GTK main thread, but
+// the channel may be read from any goroutine. Reading it from the main
+// thread would block the main loop delivering the text.
+func (v *Clipboard) ReadText(ctx context.Context) <-chan ClipboardText {
+	ch := make(chan ClipboardText, 1)
+	done := make(chan struct{})
+	var once sync.Once
+	send := func(r ClipboardText) {
+		once.Do(func() {
+			ch <- r
+			close(ch)
+			close(done)
+		})
+	}
+
+	v.RequestText(func(_ *Clipboard, text string, ok bool) {
+		if !ok {
+			send(ClipboardText{Err: errClipboardNoData})
+			return
+		}
+		send(ClipboardText{Text: text})
+	})
+	cancelOnDone(ctx, done, func(err error) { send(ClipboardText{Err: err}) })
+	return ch
+}
+
+// ReadContents requests the contents of the clipboard for target, as
+// RequestContents does, and returns a channel receiving them, or an error
+// if the retrieval failed or ctx is done first. See ReadText.
+func (v *Clipboard) ReadContents(ctx context.Context, target gdk.Atom) <-chan ClipboardContents {
+	ch := make(chan ClipboardContents, 1)
+	done := make(chan struct{})
+	var once sync.Once
+	send := func(r ClipboardContents) {
+		once.Do(func() {
+			ch <- r
+			close(ch)
+			close(done)
+		})
+	}
+
+	v.RequestContents(target, func(_ *Clipboard, data *SelectionData) {
+		if data.GetLength() < 0 {
+			send(ClipboardContents{Err: errClipboardNoData})
+			return
+		}
+		cp, err := data.Copy()
+		send(ClipboardContents{Data: cp, Err: err})
+	})
+	cancelOnDone(ctx, done, func(err error) { send(ClipboardContents{Err: err}) })
+	return ch
+}
+
+// cancelOnDone calls cancel with the error of ctx once it is done, unless
+// it can never be or done is closed first, when the result was sent.
+func cancelOnDone(ctx context.Context, done <-chan struct{}, cancel func(err error)) {
+	if ctx.Done() == nil {
+		return
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel(ctx.Err())
+		case <-done:
+		}
+	}()
+}
+
+/*
+ * Custom GtkClipboard providers
+ */
+
+// ClipboardGetFunc is called by GTK when the data of a clipboard set with
+// Clipboard.SetWithData is requested. It stores the data for the target
+// registered with info in data.
+type ClipboardGetFunc func(clipboard *Clipboard, data *SelectionData, info uint)
+
+// ClipboardClearFunc is called by GTK when the data of a clipboard set with
+// Clipboard.SetWithData is no longer needed, because another owner took
+// the clipboard.
+type ClipboardClearFunc func(clipboard *Clipboard)
+
+type clipboardProvider struct {
+	get   ClipboardGetFunc
+	clear ClipboardClearFunc
+}
+
+var (
+	clipboardProviderRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]clipboardProvider
+	}{
+		next: 1,
+		m:    make(map[int]clipboardProvider),
+	}
+)
+
+// SetWithData is a wrapper around gtk_clipboard_set_with_data(). It claims
+// the clipboard for targets without providing the data up front: get is
+// called whenever a target is requested, and clear, which may be nil, once
+// the clipboard is taken by someone else. It returns false if the clipboard
+// could not be claimed, in which case neither function is called.
+func (v *Clipboard) SetWithData(targets []TargetEntry, get ClipboardGetFunc, clear ClipboardClearFunc) bool {
+	clipboardProviderRegistry.Lock()
+	id := clipboardProviderRegistry.next
+	clipboardProviderRegistry.next++
+	clipboardProviderRegistry.m[id] = clipboardProvider{get, clear}
+	clipboardProviderRegistry.Unlock()
+
+	ctargets, n := targetEntriesNative(targets)
+	ok := gobool(C._gtk_clipboard_set_with_data(v.native(), ctargets, C.guint(n),
+		C.gpointer(uintptr(id))))
+	if !ok {
+		clipboardProviderRegistry.Lock()
+		delete(clipboardProviderRegistry.m, id)
+		clipboardProviderRegistry.Unlock()
+	}
+	return ok
+}
+
+// Clear is a wrapper around gtk_clipboard_clear().
+func (v *Clipboard) Clear() {
+	C.gtk_clipboard_clear(v.native())
+}
+
+// SetCanStore is a wrapper around gtk_clipboard_set_can_store(). It lists
+// the targets of the data that may be stored by the clipboard manager when
+// the application exits, or all of them if targets is nil.
+func (v *Clipboard) SetCanStore(targets []TargetEntry) {
+	ctargets, n := targetEntriesNative(targets)
+	C.gtk_clipboard_set_can_store(v.native(), ctargets, n)
+}
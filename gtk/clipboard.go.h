@@ -0,0 +1,38 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gtk/gtk.h>
+
+extern void goClipboardTextReceived(GtkClipboard *clipboard, gchar *text, gpointer data);
+extern void goClipboardImageReceived(GtkClipboard *clipboard, GdkPixbuf *pixbuf, gpointer data);
+extern void goClipboardURIsReceived(GtkClipboard *clipboard, gchar **uris, gpointer data);
+extern void goClipboardContentsReceived(GtkClipboard *clipboard, GtkSelectionData *selection_data, gpointer data);
+extern void goClipboardTargetsReceived(GtkClipboard *clipboard, GdkAtom *atoms, gint n_atoms, gpointer data);
+extern void goClipboardGet(GtkClipboard *clipboard, GtkSelectionData *selection_data, guint info, gpointer data);
+extern void goClipboardClear(GtkClipboard *clipboard, gpointer data);
+
+static inline void _gtk_clipboard_request_text(GtkClipboard *clipboard, gpointer data) {
+	gtk_clipboard_request_text(clipboard, (GtkClipboardTextReceivedFunc)(goClipboardTextReceived), data);
+}
+
+static inline void _gtk_clipboard_request_image(GtkClipboard *clipboard, gpointer data) {
+	gtk_clipboard_request_image(clipboard, (GtkClipboardImageReceivedFunc)(goClipboardImageReceived), data);
+}
+
+static inline void _gtk_clipboard_request_uris(GtkClipboard *clipboard, gpointer data) {
+	gtk_clipboard_request_uris(clipboard, (GtkClipboardURIReceivedFunc)(goClipboardURIsReceived), data);
+}
+
+static inline void _gtk_clipboard_request_contents(GtkClipboard *clipboard, GdkAtom target, gpointer data) {
+	gtk_clipboard_request_contents(clipboard, target, (GtkClipboardReceivedFunc)(goClipboardContentsReceived), data);
+}
+
+static inline void _gtk_clipboard_request_targets(GtkClipboard *clipboard, gpointer data) {
+	gtk_clipboard_request_targets(clipboard, (GtkClipboardTargetsReceivedFunc)(goClipboardTargetsReceived), data);
+}
+
+static inline gboolean _gtk_clipboard_set_with_data(GtkClipboard *clipboard, const GtkTargetEntry *targets, guint n_targets, gpointer data) {
+	return gtk_clipboard_set_with_data(clipboard, targets, n_targets,
+		(GtkClipboardGetFunc)(goClipboardGet), (GtkClipboardClearFunc)(goClipboardClear), data);
+}
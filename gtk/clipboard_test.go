@@ -0,0 +1,120 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestClipboardSetWithData(t *testing.T) {
+	clipboard, err := gtk.ClipboardGet(gdk.SELECTION_CLIPBOARD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := gtk.TargetEntryNew("application/x-test", 0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotInfo uint
+	var cleared bool
+	ok := clipboard.SetWithData([]gtk.TargetEntry{*entry},
+		func(_ *gtk.Clipboard, data *gtk.SelectionData, info uint) {
+			gotInfo = info
+			data.Set(data.GetTarget(), 8, []byte("provided"))
+		},
+		func(_ *gtk.Clipboard) {
+			cleared = true
+		})
+	if !ok {
+		t.Fatal("expected SetWithData to claim the clipboard")
+	}
+
+	data, err := clipboard.WaitForContents(gdk.GdkAtomIntern("application/x-test", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotInfo != 5 {
+		t.Errorf("expected info 5, got %d", gotInfo)
+	}
+	if b := string(data.GetData()); b != "provided" {
+		t.Errorf("expected %q, got %q", "provided", b)
+	}
+
+	clipboard.Clear()
+	if !cleared {
+		t.Error("expected the clear function to be called")
+	}
+}
+
+func TestClipboardRequestText(t *testing.T) {
+	clipboard, err := gtk.ClipboardGet(gdk.SELECTION_CLIPBOARD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clipboard.SetText("requested")
+
+	var got string
+	var done bool
+	clipboard.RequestText(func(_ *gtk.Clipboard, text string, ok bool) {
+		if !ok {
+			t.Error("expected the clipboard to hold text")
+		}
+		got, done = text, true
+	})
+	for !done {
+		gtk.MainIterationDo(true)
+	}
+	if got != "requested" {
+		t.Errorf("expected %q, got %q", "requested", got)
+	}
+}
+
+func TestClipboardReadText(t *testing.T) {
+	clipboard, err := gtk.ClipboardGet(gdk.SELECTION_CLIPBOARD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clipboard.SetText("read")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := clipboard.ReadText(ctx)
+	for gtk.EventsPending() {
+		gtk.MainIteration()
+	}
+	r := <-ch
+	if r.Err != nil || r.Text != "read" {
+		t.Errorf("expected %q, got %q (%v)", "read", r.Text, r.Err)
+	}
+	if _, open := <-ch; open {
+		t.Error("expected the channel to be closed after one value")
+	}
+}
+
+func TestClipboardReadContentsCanceled(t *testing.T) {
+	// The secondary selection has no owner in this process, so the request
+	// is only answered once the main loop runs, after the cancellation.
+	clipboard, err := gtk.ClipboardGet(gdk.SELECTION_SECONDARY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := clipboard.ReadContents(ctx, gdk.GdkAtomIntern("application/x-test", false))
+	cancel()
+	r := <-ch
+	if r.Err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, r.Err)
+	}
+	for gtk.EventsPending() {
+		gtk.MainIteration()
+	}
+	if _, open := <-ch; open {
+		t.Error("expected the channel to be closed after one value")
+	}
+}
@@ -52,6 +52,13 @@ func (v *ComboBox) toCellLayout() *C.GtkCellLayout {
 	return C.toGtkCellLayout(unsafe.Pointer(v.GObject))
 }
 
+func (v *ComboBox) toCellEditable() *C.GtkCellEditable {
+	if v == nil {
+		return nil
+	}
+	return C.toGtkCellEditable(unsafe.Pointer(v.GObject))
+}
+
 func marshalComboBox(p uintptr) (interface{}, error) {
 	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
 	obj := glib.Take(unsafe.Pointer(c))
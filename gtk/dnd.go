@@ -0,0 +1,408 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <stdlib.h>
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_drag_result_get_type()), marshalDragResult},
+		{glib.Type(C.gtk_target_list_get_type()), marshalTargetList},
+	}
+
+	glib.RegisterGValueMarshalers(tm)
+}
+
+// DragResult is a representation of GTK's GtkDragResult.
+type DragResult int
+
+const (
+	DRAG_RESULT_SUCCESS         DragResult = C.GTK_DRAG_RESULT_SUCCESS
+	DRAG_RESULT_NO_TARGET       DragResult = C.GTK_DRAG_RESULT_NO_TARGET
+	DRAG_RESULT_USER_CANCELLED  DragResult = C.GTK_DRAG_RESULT_USER_CANCELLED
+	DRAG_RESULT_TIMEOUT_EXPIRED DragResult = C.GTK_DRAG_RESULT_TIMEOUT_EXPIRED
+	DRAG_RESULT_GRAB_BROKEN     DragResult = C.GTK_DRAG_RESULT_GRAB_BROKEN
+	DRAG_RESULT_ERROR           DragResult = C.GTK_DRAG_RESULT_ERROR
+)
+
+func marshalDragResult(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return DragResult(c), nil
+}
+
+/*
+ * GtkTargetList
+ */
+
+// TargetList is a representation of GTK's GtkTargetList.
+type TargetList struct {
+	GtkTargetList *C.GtkTargetList
+}
+
+// native returns a pointer to the underlying GtkTargetList.
+func (v *TargetList) native() *C.GtkTargetList {
+	if v == nil {
+		return nil
+	}
+	return v.GtkTargetList
+}
+
+// Native returns a pointer to the underlying GtkTargetList.
+func (v *TargetList) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalTargetList(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	return wrapTargetList((*C.GtkTargetList)(unsafe.Pointer(c)), true), nil
+}
+
+// wrapTargetList wraps a GtkTargetList, taking a new reference if
+// needsRef is true, and releases the reference once the wrapper is
+// unreachable.
+func wrapTargetList(c *C.GtkTargetList, needsRef bool) *TargetList {
+	if c == nil {
+		return nil
+	}
+	if needsRef {
+		C.gtk_target_list_ref(c)
+	}
+	v := &TargetList{c}
+	runtime.SetFinalizer(v, (*TargetList).unref)
+	return v
+}
+
+func (v *TargetList) unref() {
+	C.gtk_target_list_unref(v.native())
+}
+
+// TargetListNew is a wrapper around gtk_target_list_new().
+func TargetListNew(targets []TargetEntry) (*TargetList, error) {
+	ctargets, n := targetEntriesNative(targets)
+	c := C.gtk_target_list_new(ctargets, C.guint(n))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapTargetList(c, false), nil
+}
+
+// Add is a wrapper around gtk_target_list_add().
+func (v *TargetList) Add(target gdk.Atom, flags TargetFlags, info uint) {
+	C.gtk_target_list_add(v.native(), C.GdkAtom(unsafe.Pointer(target)), C.guint(flags), C.guint(info))
+}
+
+// AddTable is a wrapper around gtk_target_list_add_table().
+func (v *TargetList) AddTable(targets []TargetEntry) {
+	ctargets, n := targetEntriesNative(targets)
+	C.gtk_target_list_add_table(v.native(), ctargets, C.guint(n))
+}
+
+// AddTextTargets is a wrapper around gtk_target_list_add_text_targets().
+func (v *TargetList) AddTextTargets(info uint) {
+	C.gtk_target_list_add_text_targets(v.native(), C.guint(info))
+}
+
+// AddRichTextTargets is a wrapper around gtk_target_list_add_rich_text_targets().
+func (v *TargetList) AddRichTextTargets(info uint, deserializable bool, buffer *TextBuffer) {
+	C.gtk_target_list_add_rich_text_targets(v.native(), C.guint(info), gbool(deserializable),
+		buffer.native())
+}
+
+// AddImageTargets is a wrapper around gtk_target_list_add_image_targets().
+func (v *TargetList) AddImageTargets(info uint, writable bool) {
+	C.gtk_target_list_add_image_targets(v.native(), C.guint(info), gbool(writable))
+}
+
+// AddURITargets is a wrapper around gtk_target_list_add_uri_targets().
+func (v *TargetList) AddURITargets(info uint) {
+	C.gtk_target_list_add_uri_targets(v.native(), C.guint(info))
+}
+
+// Remove is a wrapper around gtk_target_list_remove().
+func (v *TargetList) Remove(target gdk.Atom) {
+	C.gtk_target_list_remove(v.native(), C.GdkAtom(unsafe.Pointer(target)))
+}
+
+// Find is a wrapper around gtk_target_list_find(). It returns the info
+// registered for target, and false if target is not in the list.
+func (v *TargetList) Find(target gdk.Atom) (uint, bool) {
+	var info C.guint
+	c := C.gtk_target_list_find(v.native(), C.GdkAtom(unsafe.Pointer(target)), &info)
+	return uint(info), gobool(c)
+}
+
+/*
+ * GtkDragDest and GtkDragSource
+ */
+
+// DragDestSetTargetList is a wrapper around gtk_drag_dest_set_target_list().
+func (v *Widget) DragDestSetTargetList(targetList *TargetList) {
+	C.gtk_drag_dest_set_target_list(v.native(), targetList.native())
+}
+
+// DragDestGetTargetList is a wrapper around gtk_drag_dest_get_target_list().
+func (v *Widget) DragDestGetTargetList() (*TargetList, error) {
+	c := C.gtk_drag_dest_get_target_list(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapTargetList(c, true), nil
+}
+
+// DragDestAddTextTargets is a wrapper around gtk_drag_dest_add_text_targets().
+func (v *Widget) DragDestAddTextTargets() {
+	C.gtk_drag_dest_add_text_targets(v.native())
+}
+
+// DragDestAddImageTargets is a wrapper around gtk_drag_dest_add_image_targets().
+func (v *Widget) DragDestAddImageTargets() {
+	C.gtk_drag_dest_add_image_targets(v.native())
+}
+
+// DragDestAddURITargets is a wrapper around gtk_drag_dest_add_uri_targets().
+func (v *Widget) DragDestAddURITargets() {
+	C.gtk_drag_dest_add_uri_targets(v.native())
+}
+
+// DragDestFindTarget is a wrapper around gtk_drag_dest_find_target(). A nil
+// targetList uses the target list of the widget. It returns
+// 0, GDK_NONE, if no target is accepted.
+func (v *Widget) DragDestFindTarget(context *gdk.DragContext, targetList *TargetList) gdk.Atom {
+	c := C.gtk_drag_dest_find_target(v.native(), toGdkDragContext(context), targetList.native())
+	return gdk.Atom(uintptr(unsafe.Pointer(c)))
+}
+
+// DragDestSetTrackMotion is a wrapper around gtk_drag_dest_set_track_motion().
+func (v *Widget) DragDestSetTrackMotion(trackMotion bool) {
+	C.gtk_drag_dest_set_track_motion(v.native(), gbool(trackMotion))
+}
+
+// DragDestGetTrackMotion is a wrapper around gtk_drag_dest_get_track_motion().
+func (v *Widget) DragDestGetTrackMotion() bool {
+	return gobool(C.gtk_drag_dest_get_track_motion(v.native()))
+}
+
+// DragDestUnset is a wrapper around gtk_drag_dest_unset().
+func (v *Widget) DragDestUnset() {
+	C.gtk_drag_dest_unset(v.native())
+}
+
+// DragSourceSetTargetList is a wrapper around gtk_drag_source_set_target_list().
+func (v *Widget) DragSourceSetTargetList(targetList *TargetList) {
+	C.gtk_drag_source_set_target_list(v.native(), targetList.native())
+}
+
+// DragSourceGetTargetList is a wrapper around gtk_drag_source_get_target_list().
+func (v *Widget) DragSourceGetTargetList() (*TargetList, error) {
+	c := C.gtk_drag_source_get_target_list(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapTargetList(c, true), nil
+}
+
+// DragSourceAddTextTargets is a wrapper around gtk_drag_source_add_text_targets().
+func (v *Widget) DragSourceAddTextTargets() {
+	C.gtk_drag_source_add_text_targets(v.native())
+}
+
+// DragSourceAddImageTargets is a wrapper around gtk_drag_source_add_image_targets().
+func (v *Widget) DragSourceAddImageTargets() {
+	C.gtk_drag_source_add_image_targets(v.native())
+}
+
+// DragSourceAddURITargets is a wrapper around gtk_drag_source_add_uri_targets().
+func (v *Widget) DragSourceAddURITargets() {
+	C.gtk_drag_source_add_uri_targets(v.native())
+}
+
+// DragSourceSetIconName is a wrapper around gtk_drag_source_set_icon_name().
+func (v *Widget) DragSourceSetIconName(iconName string) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_drag_source_set_icon_name(v.native(), (*C.gchar)(cstr))
+}
+
+// DragSourceSetIconPixbuf is a wrapper around gtk_drag_source_set_icon_pixbuf().
+func (v *Widget) DragSourceSetIconPixbuf(pixbuf *gdk.Pixbuf) {
+	C.gtk_drag_source_set_icon_pixbuf(v.native(), (*C.GdkPixbuf)(unsafe.Pointer(pixbuf.Native())))
+}
+
+// DragSourceUnset is a wrapper around gtk_drag_source_unset().
+func (v *Widget) DragSourceUnset() {
+	C.gtk_drag_source_unset(v.native())
+}
+
+// DragGetData is a wrapper around gtk_drag_get_data(). The data is
+// delivered to the "drag-data-received" signal of the widget.
+func (v *Widget) DragGetData(context *gdk.DragContext, target gdk.Atom, time uint32) {
+	C.gtk_drag_get_data(v.native(), toGdkDragContext(context),
+		C.GdkAtom(unsafe.Pointer(target)), C.guint32(time))
+}
+
+// DragHighlight is a wrapper around gtk_drag_highlight().
+func (v *Widget) DragHighlight() {
+	C.gtk_drag_highlight(v.native())
+}
+
+// DragUnhighlight is a wrapper around gtk_drag_unhighlight().
+func (v *Widget) DragUnhighlight() {
+	C.gtk_drag_unhighlight(v.native())
+}
+
+// DragCheckThreshold is a wrapper around gtk_drag_check_threshold().
+func (v *Widget) DragCheckThreshold(startX, startY, currentX, currentY int) bool {
+	return gobool(C.gtk_drag_check_threshold(v.native(), C.gint(startX), C.gint(startY),
+		C.gint(currentX), C.gint(currentY)))
+}
+
+// DragFinish is a wrapper around gtk_drag_finish(). Drop targets call it
+// once they have received the data, deleting the source data if del is true
+// and the drag was a move.
+func DragFinish(context *gdk.DragContext, success, del bool, time uint32) {
+	C.gtk_drag_finish(toGdkDragContext(context), gbool(success), gbool(del), C.guint32(time))
+}
+
+// DragGetSourceWidget is a wrapper around gtk_drag_get_source_widget(). It
+// returns a nil IWidget if the drag comes from another application.
+func DragGetSourceWidget(context *gdk.DragContext) (IWidget, error) {
+	c := C.gtk_drag_get_source_widget(toGdkDragContext(context))
+	if c == nil {
+		return nil, nil
+	}
+	return castWidget(c)
+}
+
+// DragSetIconWidget is a wrapper around gtk_drag_set_icon_widget().
+func DragSetIconWidget(context *gdk.DragContext, widget IWidget, hotX, hotY int) {
+	C.gtk_drag_set_icon_widget(toGdkDragContext(context), widget.toWidget(), C.gint(hotX), C.gint(hotY))
+}
+
+// DragSetIconName is a wrapper around gtk_drag_set_icon_name().
+func DragSetIconName(context *gdk.DragContext, iconName string, hotX, hotY int) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_drag_set_icon_name(toGdkDragContext(context), (*C.gchar)(cstr), C.gint(hotX), C.gint(hotY))
+}
+
+// DragSetIconSurface is a wrapper around gtk_drag_set_icon_surface(). The
+// hotspot is taken from the device offset of surface.
+func DragSetIconSurface(context *gdk.DragContext, surface *cairo.Surface) {
+	C.gtk_drag_set_icon_surface(toGdkDragContext(context),
+		(*C.cairo_surface_t)(unsafe.Pointer(surface.Native())))
+}
+
+// DragSetIconDefault is a wrapper around gtk_drag_set_icon_default().
+func DragSetIconDefault(context *gdk.DragContext) {
+	C.gtk_drag_set_icon_default(toGdkDragContext(context))
+}
+
+// toGdkDragContext returns the GdkDragContext of a gdk.DragContext.
+func toGdkDragContext(context *gdk.DragContext) *C.GdkDragContext {
+	if context == nil {
+		return nil
+	}
+	return (*C.GdkDragContext)(unsafe.Pointer(context.Native()))
+}
+
+// wrapDragContext wraps a GdkDragContext, returning nil for a NULL pointer.
+func wrapDragContext(c *C.GdkDragContext) *gdk.DragContext {
+	if c == nil {
+		return nil
+	}
+	return &gdk.DragContext{glib.Take(unsafe.Pointer(c))}
+}
+
+/*
+ * Drag signals
+ */
+
+// ConnectDragBegin connects f to the "drag-begin" signal, emitted on the
+// source widget when a drag starts.
+func (v *Widget) ConnectDragBegin(f func(context *gdk.DragContext)) (glib.SignalHandle, error) {
+	return v.Connect("drag-begin", func(_ interface{}, context *gdk.DragContext) {
+		f(context)
+	})
+}
+
+// ConnectDragEnd connects f to the "drag-end" signal, emitted on the source
+// widget when a drag is finished.
+func (v *Widget) ConnectDragEnd(f func(context *gdk.DragContext)) (glib.SignalHandle, error) {
+	return v.Connect("drag-end", func(_ interface{}, context *gdk.DragContext) {
+		f(context)
+	})
+}
+
+// ConnectDragDataGet connects f to the "drag-data-get" signal, emitted on
+// the source widget when the destination requests the data. f fills data
+// in the format of the target registered with info.
+func (v *Widget) ConnectDragDataGet(f func(context *gdk.DragContext, data *SelectionData, info uint, time uint32)) (glib.SignalHandle, error) {
+	return v.Connect("drag-data-get", func(_ interface{}, context *gdk.DragContext, data uintptr, info uint, time uint32) {
+		f(context, WrapSelectionData(data), info, time)
+	})
+}
+
+// ConnectDragDataDelete connects f to the "drag-data-delete" signal,
+// emitted on the source widget after a successful move.
+func (v *Widget) ConnectDragDataDelete(f func(context *gdk.DragContext)) (glib.SignalHandle, error) {
+	return v.Connect("drag-data-delete", func(_ interface{}, context *gdk.DragContext) {
+		f(context)
+	})
+}
+
+// ConnectDragFailed connects f to the "drag-failed" signal, emitted on the
+// source widget when a drag fails. f returns true if it handled the failure,
+// which skips the default "drag operation failed" animation.
+func (v *Widget) ConnectDragFailed(f func(context *gdk.DragContext, result DragResult) bool) (glib.SignalHandle, error) {
+	return v.Connect("drag-failed", func(_ interface{}, context *gdk.DragContext, result DragResult) bool {
+		return f(context, result)
+	})
+}
+
+// ConnectDragMotion connects f to the "drag-motion" signal, emitted on the
+// destination widget when the pointer moves over it during a drag. f
+// returns whether a drop is possible at x, y, and if it is, it must call
+// context.DragStatus unless DEST_DEFAULT_MOTION is set.
+func (v *Widget) ConnectDragMotion(f func(context *gdk.DragContext, x, y int, time uint32) bool) (glib.SignalHandle, error) {
+	return v.Connect("drag-motion", func(_ interface{}, context *gdk.DragContext, x, y int, time uint32) bool {
+		return f(context, x, y, time)
+	})
+}
+
+// ConnectDragLeave connects f to the "drag-leave" signal, emitted on the
+// destination widget when the pointer leaves it during a drag.
+func (v *Widget) ConnectDragLeave(f func(context *gdk.DragContext, time uint32)) (glib.SignalHandle, error) {
+	return v.Connect("drag-leave", func(_ interface{}, context *gdk.DragContext, time uint32) {
+		f(context, time)
+	})
+}
+
+// ConnectDragDrop connects f to the "drag-drop" signal, emitted on the
+// destination widget when the user drops. f returns whether a drop is
+// possible at x, y, and if it is, it must request the data with
+// Widget.DragGetData unless DEST_DEFAULT_DROP is set.
+func (v *Widget) ConnectDragDrop(f func(context *gdk.DragContext, x, y int, time uint32) bool) (glib.SignalHandle, error) {
+	return v.Connect("drag-drop", func(_ interface{}, context *gdk.DragContext, x, y int, time uint32) bool {
+		return f(context, x, y, time)
+	})
+}
+
+// ConnectDragDataReceived connects f to the "drag-data-received" signal,
+// emitted on the destination widget when the dropped data arrives. Unless
+// DEST_DEFAULT_DROP is set, f must call DragFinish.
+func (v *Widget) ConnectDragDataReceived(f func(context *gdk.DragContext, x, y int, data *SelectionData, info uint, time uint32)) (glib.SignalHandle, error) {
+	return v.Connect("drag-data-received", func(_ interface{}, context *gdk.DragContext, x, y int, data uintptr, info uint, time uint32) {
+		f(context, x, y, WrapSelectionData(data), info, time)
+	})
+}
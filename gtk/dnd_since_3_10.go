@@ -0,0 +1,31 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6,!gtk_3_8
+
+package gtk
+
+// #include <gtk/gtk.h>
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+)
+
+// DragBeginWithCoordinates is a wrapper around
+// gtk_drag_begin_with_coordinates(). It starts a drag from the widget, for
+// instance from a gesture handler, with event being the event that
+// triggered it, or nil. x and y are the start position in widget
+// coordinates, or -1 to use the position of event.
+func (v *Widget) DragBeginWithCoordinates(targets *TargetList, actions gdk.DragAction, button int, event *gdk.Event, x, y int) (*gdk.DragContext, error) {
+	var cevent *C.GdkEvent
+	if event != nil {
+		cevent = (*C.GdkEvent)(unsafe.Pointer(event.Native()))
+	}
+	c := C.gtk_drag_begin_with_coordinates(v.native(), targets.native(),
+		C.GdkDragAction(actions), C.gint(button), cevent, C.gint(x), C.gint(y))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapDragContext(c), nil
+}
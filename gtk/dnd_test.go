@@ -0,0 +1,109 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestTargetList(t *testing.T) {
+	entry, err := gtk.TargetEntryNew("application/x-test", gtk.TARGET_SAME_APP, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := gtk.TargetListNew([]gtk.TargetEntry{*entry})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAtom := gdk.GdkAtomIntern("application/x-test", false)
+	if info, ok := list.Find(testAtom); !ok || info != 7 {
+		t.Errorf("expected info 7 for the table target, got %d (%v)", info, ok)
+	}
+
+	uriAtom := gdk.GdkAtomIntern("text/uri-list", false)
+	if _, ok := list.Find(uriAtom); ok {
+		t.Error("expected no URI target before AddURITargets")
+	}
+	list.AddURITargets(3)
+	if info, ok := list.Find(uriAtom); !ok || info != 3 {
+		t.Errorf("expected info 3 for the URI target, got %d (%v)", info, ok)
+	}
+
+	otherAtom := gdk.GdkAtomIntern("application/x-other", false)
+	list.Add(otherAtom, 0, 9)
+	list.Remove(testAtom)
+	if _, ok := list.Find(testAtom); ok {
+		t.Error("expected the removed target to be gone")
+	}
+	if info, ok := list.Find(otherAtom); !ok || info != 9 {
+		t.Errorf("expected info 9 for the added target, got %d (%v)", info, ok)
+	}
+}
+
+func TestWidgetDragTargetLists(t *testing.T) {
+	label, err := gtk.LabelNew("drag me")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := gtk.TargetEntryNew("application/x-test", gtk.TARGET_SAME_APP, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	label.DragDestSet(gtk.DEST_DEFAULT_ALL, []gtk.TargetEntry{*entry}, gdk.ACTION_COPY)
+	label.DragDestAddTextTargets()
+	list, err := label.DragDestGetTargetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := list.Find(gdk.GdkAtomIntern("UTF8_STRING", false)); !ok {
+		t.Error("expected text targets on the drag destination")
+	}
+
+	label.DragDestSetTrackMotion(true)
+	if !label.DragDestGetTrackMotion() {
+		t.Error("expected motion tracking to be enabled")
+	}
+	label.DragDestUnset()
+
+	label.DragSourceSet(gdk.BUTTON1_MASK, []gtk.TargetEntry{*entry}, gdk.ACTION_MOVE)
+	label.DragSourceAddURITargets()
+	list, err = label.DragSourceGetTargetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := list.Find(gdk.GdkAtomIntern("text/uri-list", false)); !ok {
+		t.Error("expected URI targets on the drag source")
+	}
+	label.DragSourceUnset()
+
+	if !label.DragCheckThreshold(0, 0, 100, 100) {
+		t.Error("expected a long move to exceed the drag threshold")
+	}
+	if label.DragCheckThreshold(0, 0, 1, 1) {
+		t.Error("expected a short move to stay within the drag threshold")
+	}
+}
+
+func TestEntrySetIconDragSource(t *testing.T) {
+	entry, err := gtk.EntryNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry.SetIconFromIconName(gtk.ENTRY_ICON_PRIMARY, "edit-copy")
+
+	list, err := gtk.TargetListNew(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list.AddTextTargets(0)
+	entry.SetIconDragSource(gtk.ENTRY_ICON_PRIMARY, list, gdk.ACTION_COPY)
+
+	if i := entry.GetCurrentIconDragSource(); i != -1 {
+		t.Errorf("expected no icon drag in progress, got %d", i)
+	}
+}
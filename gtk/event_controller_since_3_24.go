@@ -0,0 +1,284 @@
+// Same copyright and license as the rest of the files in this project
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!gtk_3_18,!gtk_3_20,!gtk_3_22
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "event_controller_since_3_24.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.gtk_event_controller_scroll_flags_get_type()), marshalEventControllerScrollFlags},
+
+		// Objects/Interfaces
+		{glib.Type(C.gtk_event_controller_key_get_type()), marshalEventControllerKey},
+		{glib.Type(C.gtk_event_controller_motion_get_type()), marshalEventControllerMotion},
+		{glib.Type(C.gtk_event_controller_scroll_get_type()), marshalEventControllerScroll},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	//Contribute to casting
+	for k, v := range map[string]WrapFn{
+		"GtkEventControllerKey":    wrapEventControllerKey,
+		"GtkEventControllerMotion": wrapEventControllerMotion,
+		"GtkEventControllerScroll": wrapEventControllerScroll,
+	} {
+		WrapMap[k] = v
+	}
+}
+
+/*
+ * Constants
+ */
+
+// EventControllerScrollFlags is a representation of GTK's GtkEventControllerScrollFlags.
+type EventControllerScrollFlags int
+
+const (
+	EVENT_CONTROLLER_SCROLL_NONE       EventControllerScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_NONE
+	EVENT_CONTROLLER_SCROLL_VERTICAL   EventControllerScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_VERTICAL
+	EVENT_CONTROLLER_SCROLL_HORIZONTAL EventControllerScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_HORIZONTAL
+	EVENT_CONTROLLER_SCROLL_DISCRETE   EventControllerScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_DISCRETE
+	EVENT_CONTROLLER_SCROLL_KINETIC    EventControllerScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_KINETIC
+	EVENT_CONTROLLER_SCROLL_BOTH_AXES  EventControllerScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_BOTH_AXES
+)
+
+func marshalEventControllerScrollFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return EventControllerScrollFlags(c), nil
+}
+
+/*
+ * GtkEventControllerKey
+ */
+
+// EventControllerKey is a representation of GTK's GtkEventControllerKey.
+type EventControllerKey struct {
+	EventController
+}
+
+// native returns a pointer to the underlying GtkEventControllerKey.
+func (v *EventControllerKey) native() *C.GtkEventControllerKey {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkEventControllerKey(p)
+}
+
+func marshalEventControllerKey(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapEventControllerKey(obj), nil
+}
+
+func wrapEventControllerKey(obj *glib.Object) *EventControllerKey {
+	return &EventControllerKey{EventController{obj}}
+}
+
+// EventControllerKeyNew is a wrapper around gtk_event_controller_key_new().
+func EventControllerKeyNew(widget IWidget) (*EventControllerKey, error) {
+	c := C.gtk_event_controller_key_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapEventControllerKey(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// Forward is a wrapper around gtk_event_controller_key_forward().
+func (v *EventControllerKey) Forward(widget IWidget) bool {
+	return gobool(C.gtk_event_controller_key_forward(v.native(), widget.toWidget()))
+}
+
+// GetGroup is a wrapper around gtk_event_controller_key_get_group().
+func (v *EventControllerKey) GetGroup() uint {
+	return uint(C.gtk_event_controller_key_get_group(v.native()))
+}
+
+// TODO:
+// gtk_event_controller_key_set_im_context().
+// gtk_event_controller_key_get_im_context().
+
+// ConnectKeyPressed connects f to the "key-pressed" signal. f returns true
+// if it handled the key press, which stops its propagation.
+func (v *EventControllerKey) ConnectKeyPressed(f func(keyval, keycode uint, state gdk.ModifierType) bool) (glib.SignalHandle, error) {
+	return v.Connect("key-pressed", func(_ interface{}, keyval, keycode uint, state gdk.ModifierType) bool {
+		return f(keyval, keycode, state)
+	})
+}
+
+// ConnectKeyReleased connects f to the "key-released" signal.
+func (v *EventControllerKey) ConnectKeyReleased(f func(keyval, keycode uint, state gdk.ModifierType)) (glib.SignalHandle, error) {
+	return v.Connect("key-released", func(_ interface{}, keyval, keycode uint, state gdk.ModifierType) {
+		f(keyval, keycode, state)
+	})
+}
+
+// ConnectModifiers connects f to the "modifiers" signal, emitted when the
+// state of the modifier keys changes.
+func (v *EventControllerKey) ConnectModifiers(f func(state gdk.ModifierType) bool) (glib.SignalHandle, error) {
+	return v.Connect("modifiers", func(_ interface{}, state gdk.ModifierType) bool {
+		return f(state)
+	})
+}
+
+// ConnectFocusIn connects f to the "focus-in" signal.
+func (v *EventControllerKey) ConnectFocusIn(f func()) (glib.SignalHandle, error) {
+	return v.Connect("focus-in", func(_ interface{}) {
+		f()
+	})
+}
+
+// ConnectFocusOut connects f to the "focus-out" signal.
+func (v *EventControllerKey) ConnectFocusOut(f func()) (glib.SignalHandle, error) {
+	return v.Connect("focus-out", func(_ interface{}) {
+		f()
+	})
+}
+
+/*
+ * GtkEventControllerMotion
+ */
+
+// EventControllerMotion is a representation of GTK's GtkEventControllerMotion.
+type EventControllerMotion struct {
+	EventController
+}
+
+// native returns a pointer to the underlying GtkEventControllerMotion.
+func (v *EventControllerMotion) native() *C.GtkEventControllerMotion {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkEventControllerMotion(p)
+}
+
+func marshalEventControllerMotion(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapEventControllerMotion(obj), nil
+}
+
+func wrapEventControllerMotion(obj *glib.Object) *EventControllerMotion {
+	return &EventControllerMotion{EventController{obj}}
+}
+
+// EventControllerMotionNew is a wrapper around gtk_event_controller_motion_new().
+func EventControllerMotionNew(widget IWidget) (*EventControllerMotion, error) {
+	c := C.gtk_event_controller_motion_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapEventControllerMotion(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// ConnectEnter connects f to the "enter" signal, emitted when the pointer
+// enters the widget.
+func (v *EventControllerMotion) ConnectEnter(f func(x, y float64)) (glib.SignalHandle, error) {
+	return v.Connect("enter", func(_ interface{}, x, y float64) {
+		f(x, y)
+	})
+}
+
+// ConnectLeave connects f to the "leave" signal, emitted when the pointer
+// leaves the widget.
+func (v *EventControllerMotion) ConnectLeave(f func()) (glib.SignalHandle, error) {
+	return v.Connect("leave", func(_ interface{}) {
+		f()
+	})
+}
+
+// ConnectMotion connects f to the "motion" signal, emitted when the pointer
+// moves over the widget.
+func (v *EventControllerMotion) ConnectMotion(f func(x, y float64)) (glib.SignalHandle, error) {
+	return v.Connect("motion", func(_ interface{}, x, y float64) {
+		f(x, y)
+	})
+}
+
+/*
+ * GtkEventControllerScroll
+ */
+
+// EventControllerScroll is a representation of GTK's GtkEventControllerScroll.
+type EventControllerScroll struct {
+	EventController
+}
+
+// native returns a pointer to the underlying GtkEventControllerScroll.
+func (v *EventControllerScroll) native() *C.GtkEventControllerScroll {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkEventControllerScroll(p)
+}
+
+func marshalEventControllerScroll(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapEventControllerScroll(obj), nil
+}
+
+func wrapEventControllerScroll(obj *glib.Object) *EventControllerScroll {
+	return &EventControllerScroll{EventController{obj}}
+}
+
+// EventControllerScrollNew is a wrapper around gtk_event_controller_scroll_new().
+func EventControllerScrollNew(widget IWidget, flags EventControllerScrollFlags) (*EventControllerScroll, error) {
+	c := C.gtk_event_controller_scroll_new(widget.toWidget(), C.GtkEventControllerScrollFlags(flags))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapEventControllerScroll(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetFlags is a wrapper around gtk_event_controller_scroll_get_flags().
+func (v *EventControllerScroll) GetFlags() EventControllerScrollFlags {
+	return EventControllerScrollFlags(C.gtk_event_controller_scroll_get_flags(v.native()))
+}
+
+// SetFlags is a wrapper around gtk_event_controller_scroll_set_flags().
+func (v *EventControllerScroll) SetFlags(flags EventControllerScrollFlags) {
+	C.gtk_event_controller_scroll_set_flags(v.native(), C.GtkEventControllerScrollFlags(flags))
+}
+
+// ConnectScroll connects f to the "scroll" signal, emitted with the scroll
+// deltas along each axis.
+func (v *EventControllerScroll) ConnectScroll(f func(dx, dy float64)) (glib.SignalHandle, error) {
+	return v.Connect("scroll", func(_ interface{}, dx, dy float64) {
+		f(dx, dy)
+	})
+}
+
+// ConnectScrollBegin connects f to the "scroll-begin" signal, emitted when
+// a smooth scroll sequence starts.
+func (v *EventControllerScroll) ConnectScrollBegin(f func()) (glib.SignalHandle, error) {
+	return v.Connect("scroll-begin", func(_ interface{}) {
+		f()
+	})
+}
+
+// ConnectScrollEnd connects f to the "scroll-end" signal.
+func (v *EventControllerScroll) ConnectScrollEnd(f func()) (glib.SignalHandle, error) {
+	return v.Connect("scroll-end", func(_ interface{}) {
+		f()
+	})
+}
+
+// ConnectDecelerate connects f to the "decelerate" signal, emitted with the
+// initial velocity of a kinetic scroll.
+func (v *EventControllerScroll) ConnectDecelerate(f func(velocityX, velocityY float64)) (glib.SignalHandle, error) {
+	return v.Connect("decelerate", func(_ interface{}, velocityX, velocityY float64) {
+		f(velocityX, velocityY)
+	})
+}
@@ -0,0 +1,19 @@
+// Same copyright and license as the rest of the files in this project
+
+static GtkEventControllerKey *
+toGtkEventControllerKey(void *p)
+{
+	return (GTK_EVENT_CONTROLLER_KEY(p));
+}
+
+static GtkEventControllerMotion *
+toGtkEventControllerMotion(void *p)
+{
+	return (GTK_EVENT_CONTROLLER_MOTION(p));
+}
+
+static GtkEventControllerScroll *
+toGtkEventControllerScroll(void *p)
+{
+	return (GTK_EVENT_CONTROLLER_SCROLL(p));
+}
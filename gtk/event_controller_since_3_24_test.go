@@ -0,0 +1,147 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!gtk_3_18,!gtk_3_20,!gtk_3_22
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func (tt *gestureTestTarget) key(typ gdk.EventType, keyval uint, state gdk.ModifierType) *gdk.Event {
+	e := tt.event(typ)
+	k := gdk.EventKeyNewFromEvent(e)
+	k.SetKeyVal(keyval)
+	k.SetHardwareKeyCode(38)
+	k.SetState(state)
+	return e
+}
+
+func (tt *gestureTestTarget) crossing(typ gdk.EventType, x, y float64) *gdk.Event {
+	e := tt.event(typ)
+	c := gdk.EventCrossingNewFromEvent(e)
+	c.SetMotionVal(x, y)
+	return e
+}
+
+func (tt *gestureTestTarget) scroll(direction gdk.ScrollDirection, dx, dy float64) *gdk.Event {
+	e := tt.event(gdk.EVENT_SCROLL)
+	s := gdk.EventScrollNewFromEvent(e)
+	s.SetMotionVal(50, 50)
+	s.SetDirection(direction)
+	s.SetDeltas(dx, dy)
+	return e
+}
+
+func TestEventControllerKey(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	controller, err := gtk.EventControllerKeyNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pressed, released uint
+	var modifiers []gdk.ModifierType
+	controller.ConnectKeyPressed(func(keyval, keycode uint, state gdk.ModifierType) bool {
+		pressed = keyval
+		if keycode != 38 || state != gdk.SHIFT_MASK {
+			t.Errorf("unexpected key press with the keycode %d and state %v", keycode, state)
+		}
+		return true
+	})
+	controller.ConnectKeyReleased(func(keyval, keycode uint, state gdk.ModifierType) {
+		released = keyval
+	})
+	controller.ConnectModifiers(func(state gdk.ModifierType) bool {
+		modifiers = append(modifiers, state)
+		return false
+	})
+
+	if !controller.HandleEvent(tt.key(gdk.EVENT_KEY_PRESS, gdk.KEY_a, gdk.SHIFT_MASK)) {
+		t.Error("expected the key press to be handled")
+	}
+	if !controller.HandleEvent(tt.key(gdk.EVENT_KEY_RELEASE, gdk.KEY_a, 0)) {
+		t.Error("expected the release of a handled key press to be handled")
+	}
+
+	if pressed != gdk.KEY_a || released != gdk.KEY_a {
+		t.Errorf("expected the a key to be pressed and released, got %d and %d", pressed, released)
+	}
+	if len(modifiers) != 2 || modifiers[0] != gdk.SHIFT_MASK || modifiers[1] != 0 {
+		t.Errorf("expected the modifiers to change to shift and back, got %v", modifiers)
+	}
+}
+
+func TestEventControllerMotion(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	controller, err := gtk.EventControllerMotionNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var events []string
+	var lastX, lastY float64
+	controller.ConnectEnter(func(x, y float64) {
+		events = append(events, "enter")
+		lastX, lastY = x, y
+	})
+	controller.ConnectMotion(func(x, y float64) {
+		events = append(events, "motion")
+		lastX, lastY = x, y
+	})
+	controller.ConnectLeave(func() {
+		events = append(events, "leave")
+	})
+
+	controller.HandleEvent(tt.crossing(gdk.EVENT_ENTER_NOTIFY, 5, 6))
+	if lastX != 5 || lastY != 6 {
+		t.Errorf("expected enter at 5,6, got %v,%v", lastX, lastY)
+	}
+	controller.HandleEvent(tt.motion(30, 40, 2))
+	if lastX != 30 || lastY != 40 {
+		t.Errorf("expected motion to 30,40, got %v,%v", lastX, lastY)
+	}
+	controller.HandleEvent(tt.crossing(gdk.EVENT_LEAVE_NOTIFY, 120, 40))
+
+	if len(events) != 3 || events[0] != "enter" || events[1] != "motion" || events[2] != "leave" {
+		t.Errorf("expected enter, motion and leave, got %v", events)
+	}
+}
+
+func TestEventControllerScroll(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	controller, err := gtk.EventControllerScrollNew(tt.area, gtk.EVENT_CONTROLLER_SCROLL_VERTICAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dx, dy float64
+	controller.ConnectScroll(func(x, y float64) {
+		dx, dy = x, y
+	})
+
+	if !controller.HandleEvent(tt.scroll(gdk.SCROLL_DOWN, 0, 0)) {
+		t.Error("expected the scroll to be handled")
+	}
+	if dx != 0 || dy != 1 {
+		t.Errorf("expected a scroll of one step down, got %v,%v", dx, dy)
+	}
+
+	// The horizontal delta is dropped by a vertical controller.
+	controller.HandleEvent(tt.scroll(gdk.SCROLL_SMOOTH, 3, -2))
+	if dx != 0 || dy != -2 {
+		t.Errorf("expected a vertical scroll of -2, got %v,%v", dx, dy)
+	}
+
+	controller.SetFlags(gtk.EVENT_CONTROLLER_SCROLL_BOTH_AXES)
+	if f := controller.GetFlags(); f != gtk.EVENT_CONTROLLER_SCROLL_BOTH_AXES {
+		t.Errorf("expected the flags %v, got %v", gtk.EVENT_CONTROLLER_SCROLL_BOTH_AXES, f)
+	}
+	controller.HandleEvent(tt.scroll(gdk.SCROLL_SMOOTH, 3, -2))
+	if dx != 3 || dy != -2 {
+		t.Errorf("expected a scroll of 3,-2, got %v,%v", dx, dy)
+	}
+}
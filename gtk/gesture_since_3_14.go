@@ -0,0 +1,911 @@
+// Same copyright and license as the rest of the files in this project
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gesture_since_3_14.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.gtk_propagation_phase_get_type()), marshalPropagationPhase},
+		{glib.Type(C.gtk_event_sequence_state_get_type()), marshalEventSequenceState},
+		{glib.Type(C.gtk_pan_direction_get_type()), marshalPanDirection},
+
+		// Objects/Interfaces
+		{glib.Type(C.gtk_event_controller_get_type()), marshalEventController},
+		{glib.Type(C.gtk_gesture_get_type()), marshalGesture},
+		{glib.Type(C.gtk_gesture_single_get_type()), marshalGestureSingle},
+		{glib.Type(C.gtk_gesture_drag_get_type()), marshalGestureDrag},
+		{glib.Type(C.gtk_gesture_long_press_get_type()), marshalGestureLongPress},
+		{glib.Type(C.gtk_gesture_multi_press_get_type()), marshalGestureMultiPress},
+		{glib.Type(C.gtk_gesture_pan_get_type()), marshalGesturePan},
+		{glib.Type(C.gtk_gesture_swipe_get_type()), marshalGestureSwipe},
+		{glib.Type(C.gtk_gesture_rotate_get_type()), marshalGestureRotate},
+		{glib.Type(C.gtk_gesture_zoom_get_type()), marshalGestureZoom},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	//Contribute to casting
+	for k, v := range map[string]WrapFn{
+		"GtkEventController":   wrapEventController,
+		"GtkGesture":           wrapGesture,
+		"GtkGestureSingle":     wrapGestureSingle,
+		"GtkGestureDrag":       wrapGestureDrag,
+		"GtkGestureLongPress":  wrapGestureLongPress,
+		"GtkGestureMultiPress": wrapGestureMultiPress,
+		"GtkGesturePan":        wrapGesturePan,
+		"GtkGestureSwipe":      wrapGestureSwipe,
+		"GtkGestureRotate":     wrapGestureRotate,
+		"GtkGestureZoom":       wrapGestureZoom,
+	} {
+		WrapMap[k] = v
+	}
+}
+
+/*
+ * Constants
+ */
+
+// PropagationPhase is a representation of GTK's GtkPropagationPhase.
+type PropagationPhase int
+
+const (
+	PHASE_NONE    PropagationPhase = C.GTK_PHASE_NONE
+	PHASE_CAPTURE PropagationPhase = C.GTK_PHASE_CAPTURE
+	PHASE_BUBBLE  PropagationPhase = C.GTK_PHASE_BUBBLE
+	PHASE_TARGET  PropagationPhase = C.GTK_PHASE_TARGET
+)
+
+func marshalPropagationPhase(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return PropagationPhase(c), nil
+}
+
+// EventSequenceState is a representation of GTK's GtkEventSequenceState.
+type EventSequenceState int
+
+const (
+	EVENT_SEQUENCE_NONE    EventSequenceState = C.GTK_EVENT_SEQUENCE_NONE
+	EVENT_SEQUENCE_CLAIMED EventSequenceState = C.GTK_EVENT_SEQUENCE_CLAIMED
+	EVENT_SEQUENCE_DENIED  EventSequenceState = C.GTK_EVENT_SEQUENCE_DENIED
+)
+
+func marshalEventSequenceState(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return EventSequenceState(c), nil
+}
+
+// PanDirection is a representation of GTK's GtkPanDirection.
+type PanDirection int
+
+const (
+	PAN_DIRECTION_LEFT  PanDirection = C.GTK_PAN_DIRECTION_LEFT
+	PAN_DIRECTION_RIGHT PanDirection = C.GTK_PAN_DIRECTION_RIGHT
+	PAN_DIRECTION_UP    PanDirection = C.GTK_PAN_DIRECTION_UP
+	PAN_DIRECTION_DOWN  PanDirection = C.GTK_PAN_DIRECTION_DOWN
+)
+
+func marshalPanDirection(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return PanDirection(c), nil
+}
+
+/*
+ * GtkEventController
+ */
+
+// IEventController is a representation of GtkEventController and the types
+// deriving from it.
+type IEventController interface {
+	toEventController() *C.GtkEventController
+}
+
+// EventController is a representation of GTK's GtkEventController.
+//
+// As in GTK 3, a widget does not keep its event controllers alive: keep a
+// reference to the controller for as long as it should handle events.
+type EventController struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkEventController.
+func (v *EventController) native() *C.GtkEventController {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkEventController(p)
+}
+
+func (v *EventController) toEventController() *C.GtkEventController {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+func marshalEventController(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapEventController(obj), nil
+}
+
+func wrapEventController(obj *glib.Object) *EventController {
+	return &EventController{obj}
+}
+
+// GetWidget is a wrapper around gtk_event_controller_get_widget().
+func (v *EventController) GetWidget() (IWidget, error) {
+	c := C.gtk_event_controller_get_widget(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return castWidget(c)
+}
+
+// HandleEvent is a wrapper around gtk_event_controller_handle_event(). It
+// feeds event to the controller, which is mostly useful to drive
+// controllers from synthesized events in tests, see gdk.EventNew.
+func (v *EventController) HandleEvent(event *gdk.Event) bool {
+	e := (*C.GdkEvent)(unsafe.Pointer(event.Native()))
+	return gobool(C.gtk_event_controller_handle_event(v.native(), e))
+}
+
+// Reset is a wrapper around gtk_event_controller_reset().
+func (v *EventController) Reset() {
+	C.gtk_event_controller_reset(v.native())
+}
+
+// GetPropagationPhase is a wrapper around gtk_event_controller_get_propagation_phase().
+func (v *EventController) GetPropagationPhase() PropagationPhase {
+	return PropagationPhase(C.gtk_event_controller_get_propagation_phase(v.native()))
+}
+
+// SetPropagationPhase is a wrapper around gtk_event_controller_set_propagation_phase().
+func (v *EventController) SetPropagationPhase(phase PropagationPhase) {
+	C.gtk_event_controller_set_propagation_phase(v.native(), C.GtkPropagationPhase(phase))
+}
+
+/*
+ * GtkGesture
+ */
+
+// IGesture is a representation of GtkGesture and the types deriving from it.
+type IGesture interface {
+	IEventController
+	toGesture() *C.GtkGesture
+}
+
+// Gesture is a representation of GTK's GtkGesture.
+type Gesture struct {
+	EventController
+}
+
+// native returns a pointer to the underlying GtkGesture.
+func (v *Gesture) native() *C.GtkGesture {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGesture(p)
+}
+
+func (v *Gesture) toGesture() *C.GtkGesture {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+func marshalGesture(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGesture(obj), nil
+}
+
+func wrapGesture(obj *glib.Object) *Gesture {
+	return &Gesture{EventController{obj}}
+}
+
+// GetDevice is a wrapper around gtk_gesture_get_device().
+func (v *Gesture) GetDevice() *gdk.Device {
+	c := C.gtk_gesture_get_device(v.native())
+	if c == nil {
+		return nil
+	}
+	return &gdk.Device{glib.Take(unsafe.Pointer(c))}
+}
+
+// GetWindow is a wrapper around gtk_gesture_get_window().
+func (v *Gesture) GetWindow() *gdk.Window {
+	c := C.gtk_gesture_get_window(v.native())
+	if c == nil {
+		return nil
+	}
+	return &gdk.Window{glib.Take(unsafe.Pointer(c))}
+}
+
+// SetWindow is a wrapper around gtk_gesture_set_window().
+func (v *Gesture) SetWindow(window *gdk.Window) {
+	C.gtk_gesture_set_window(v.native(), (*C.GdkWindow)(unsafe.Pointer(window.Native())))
+}
+
+// IsActive is a wrapper around gtk_gesture_is_active().
+func (v *Gesture) IsActive() bool {
+	return gobool(C.gtk_gesture_is_active(v.native()))
+}
+
+// IsRecognized is a wrapper around gtk_gesture_is_recognized().
+func (v *Gesture) IsRecognized() bool {
+	return gobool(C.gtk_gesture_is_recognized(v.native()))
+}
+
+// GetSequenceState is a wrapper around gtk_gesture_get_sequence_state().
+func (v *Gesture) GetSequenceState(sequence *gdk.EventSequence) EventSequenceState {
+	c := C.gtk_gesture_get_sequence_state(v.native(), nativeEventSequence(sequence))
+	return EventSequenceState(c)
+}
+
+// SetSequenceState is a wrapper around gtk_gesture_set_sequence_state().
+func (v *Gesture) SetSequenceState(sequence *gdk.EventSequence, state EventSequenceState) bool {
+	c := C.gtk_gesture_set_sequence_state(v.native(), nativeEventSequence(sequence), C.GtkEventSequenceState(state))
+	return gobool(c)
+}
+
+// SetState is a wrapper around gtk_gesture_set_state().
+func (v *Gesture) SetState(state EventSequenceState) bool {
+	return gobool(C.gtk_gesture_set_state(v.native(), C.GtkEventSequenceState(state)))
+}
+
+// GetSequences is a wrapper around gtk_gesture_get_sequences(). The NULL
+// sequence of pointer events is returned as a nil *gdk.EventSequence.
+func (v *Gesture) GetSequences() []*gdk.EventSequence {
+	clist := C.gtk_gesture_get_sequences(v.native())
+	if clist == nil {
+		return nil
+	}
+	defer C.g_list_free(clist)
+
+	var sequences []*gdk.EventSequence
+	for l := clist; l != nil; l = l.next {
+		sequences = append(sequences, gdk.WrapEventSequence(uintptr(unsafe.Pointer(l.data))))
+	}
+	return sequences
+}
+
+// GetLastUpdatedSequence is a wrapper around gtk_gesture_get_last_updated_sequence().
+func (v *Gesture) GetLastUpdatedSequence() *gdk.EventSequence {
+	c := C.gtk_gesture_get_last_updated_sequence(v.native())
+	return gdk.WrapEventSequence(uintptr(unsafe.Pointer(c)))
+}
+
+// HandlesSequence is a wrapper around gtk_gesture_handles_sequence().
+func (v *Gesture) HandlesSequence(sequence *gdk.EventSequence) bool {
+	return gobool(C.gtk_gesture_handles_sequence(v.native(), nativeEventSequence(sequence)))
+}
+
+// GetLastEvent is a wrapper around gtk_gesture_get_last_event(). The event
+// is owned by the gesture and only valid until the next event is handled.
+func (v *Gesture) GetLastEvent(sequence *gdk.EventSequence) *gdk.Event {
+	c := C.gtk_gesture_get_last_event(v.native(), nativeEventSequence(sequence))
+	return gdk.WrapEvent(uintptr(unsafe.Pointer(c)))
+}
+
+// GetPoint is a wrapper around gtk_gesture_get_point().
+func (v *Gesture) GetPoint(sequence *gdk.EventSequence) (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = gobool(C.gtk_gesture_get_point(v.native(), nativeEventSequence(sequence), &cx, &cy))
+	return float64(cx), float64(cy), ok
+}
+
+// GetBoundingBox is a wrapper around gtk_gesture_get_bounding_box().
+func (v *Gesture) GetBoundingBox() (*gdk.Rectangle, bool) {
+	var crect C.GdkRectangle
+	ok := gobool(C.gtk_gesture_get_bounding_box(v.native(), &crect))
+	if !ok {
+		return nil, false
+	}
+	return gdk.WrapRectangle(uintptr(unsafe.Pointer(&crect))), true
+}
+
+// GetBoundingBoxCenter is a wrapper around gtk_gesture_get_bounding_box_center().
+func (v *Gesture) GetBoundingBoxCenter() (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = gobool(C.gtk_gesture_get_bounding_box_center(v.native(), &cx, &cy))
+	return float64(cx), float64(cy), ok
+}
+
+// Group is a wrapper around gtk_gesture_group(). It adds v to the group of
+// groupGesture, so that both share the state of the sequences they handle:
+// claiming a sequence in one claims it in all.
+func (v *Gesture) Group(groupGesture IGesture) {
+	C.gtk_gesture_group(groupGesture.toGesture(), v.native())
+}
+
+// Ungroup is a wrapper around gtk_gesture_ungroup().
+func (v *Gesture) Ungroup() {
+	C.gtk_gesture_ungroup(v.native())
+}
+
+// GetGroup is a wrapper around gtk_gesture_get_group().
+func (v *Gesture) GetGroup() ([]IGesture, error) {
+	clist := C.gtk_gesture_get_group(v.native())
+	if clist == nil {
+		return nil, nil
+	}
+	defer C.g_list_free(clist)
+
+	var gestures []IGesture
+	for l := clist; l != nil; l = l.next {
+		obj, err := castGesture((*C.GtkGesture)(unsafe.Pointer(l.data)))
+		if err != nil {
+			return nil, err
+		}
+		gestures = append(gestures, obj)
+	}
+	return gestures, nil
+}
+
+// IsGroupedWith is a wrapper around gtk_gesture_is_grouped_with().
+func (v *Gesture) IsGroupedWith(other IGesture) bool {
+	return gobool(C.gtk_gesture_is_grouped_with(v.native(), other.toGesture()))
+}
+
+// ConnectBegin connects f to the "begin" signal, emitted when the gesture
+// is recognized.
+func (v *Gesture) ConnectBegin(f func(sequence *gdk.EventSequence)) (glib.SignalHandle, error) {
+	return v.Connect("begin", func(_ interface{}, sequence uintptr) {
+		f(gdk.WrapEventSequence(sequence))
+	})
+}
+
+// ConnectEnd connects f to the "end" signal, emitted when the gesture
+// either stopped recognizing the event sequences or was cancelled.
+func (v *Gesture) ConnectEnd(f func(sequence *gdk.EventSequence)) (glib.SignalHandle, error) {
+	return v.Connect("end", func(_ interface{}, sequence uintptr) {
+		f(gdk.WrapEventSequence(sequence))
+	})
+}
+
+// ConnectUpdate connects f to the "update" signal, emitted whenever an
+// event is handled while the gesture is recognized.
+func (v *Gesture) ConnectUpdate(f func(sequence *gdk.EventSequence)) (glib.SignalHandle, error) {
+	return v.Connect("update", func(_ interface{}, sequence uintptr) {
+		f(gdk.WrapEventSequence(sequence))
+	})
+}
+
+// ConnectCancel connects f to the "cancel" signal, emitted when a sequence
+// handled by the gesture is cancelled.
+func (v *Gesture) ConnectCancel(f func(sequence *gdk.EventSequence)) (glib.SignalHandle, error) {
+	return v.Connect("cancel", func(_ interface{}, sequence uintptr) {
+		f(gdk.WrapEventSequence(sequence))
+	})
+}
+
+// ConnectSequenceStateChanged connects f to the "sequence-state-changed"
+// signal.
+func (v *Gesture) ConnectSequenceStateChanged(f func(sequence *gdk.EventSequence, state EventSequenceState)) (glib.SignalHandle, error) {
+	return v.Connect("sequence-state-changed", func(_ interface{}, sequence uintptr, state EventSequenceState) {
+		f(gdk.WrapEventSequence(sequence), state)
+	})
+}
+
+// castGesture wraps c in the Go type matching its runtime GType.
+func castGesture(c *C.GtkGesture) (IGesture, error) {
+	ptr := unsafe.Pointer(c)
+	var (
+		className = goString(C.object_get_class_name(C.toGObject(ptr)))
+		obj       = glib.Take(ptr)
+	)
+
+	intf, err := castInternal(className, obj)
+	if err != nil {
+		return nil, err
+	}
+	gesture, ok := intf.(IGesture)
+	if !ok {
+		return wrapGesture(obj), nil
+	}
+	return gesture, nil
+}
+
+// nativeEventSequence returns the GdkEventSequence pointer of sequence,
+// which is NULL for a nil sequence.
+func nativeEventSequence(sequence *gdk.EventSequence) *C.GdkEventSequence {
+	return (*C.GdkEventSequence)(unsafe.Pointer(sequence.Native()))
+}
+
+/*
+ * GtkGestureSingle
+ */
+
+// GestureSingle is a representation of GTK's GtkGestureSingle.
+type GestureSingle struct {
+	Gesture
+}
+
+// native returns a pointer to the underlying GtkGestureSingle.
+func (v *GestureSingle) native() *C.GtkGestureSingle {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureSingle(p)
+}
+
+func marshalGestureSingle(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureSingle(obj), nil
+}
+
+func wrapGestureSingle(obj *glib.Object) *GestureSingle {
+	return &GestureSingle{Gesture{EventController{obj}}}
+}
+
+// GetTouchOnly is a wrapper around gtk_gesture_single_get_touch_only().
+func (v *GestureSingle) GetTouchOnly() bool {
+	return gobool(C.gtk_gesture_single_get_touch_only(v.native()))
+}
+
+// SetTouchOnly is a wrapper around gtk_gesture_single_set_touch_only().
+func (v *GestureSingle) SetTouchOnly(touchOnly bool) {
+	C.gtk_gesture_single_set_touch_only(v.native(), gbool(touchOnly))
+}
+
+// GetExclusive is a wrapper around gtk_gesture_single_get_exclusive().
+func (v *GestureSingle) GetExclusive() bool {
+	return gobool(C.gtk_gesture_single_get_exclusive(v.native()))
+}
+
+// SetExclusive is a wrapper around gtk_gesture_single_set_exclusive().
+func (v *GestureSingle) SetExclusive(exclusive bool) {
+	C.gtk_gesture_single_set_exclusive(v.native(), gbool(exclusive))
+}
+
+// GetButton is a wrapper around gtk_gesture_single_get_button().
+func (v *GestureSingle) GetButton() uint {
+	return uint(C.gtk_gesture_single_get_button(v.native()))
+}
+
+// SetButton is a wrapper around gtk_gesture_single_set_button(). A button
+// of 0 makes the gesture listen to all buttons.
+func (v *GestureSingle) SetButton(button uint) {
+	C.gtk_gesture_single_set_button(v.native(), C.guint(button))
+}
+
+// GetCurrentButton is a wrapper around gtk_gesture_single_get_current_button().
+func (v *GestureSingle) GetCurrentButton() uint {
+	return uint(C.gtk_gesture_single_get_current_button(v.native()))
+}
+
+// GetCurrentSequence is a wrapper around gtk_gesture_single_get_current_sequence().
+func (v *GestureSingle) GetCurrentSequence() *gdk.EventSequence {
+	c := C.gtk_gesture_single_get_current_sequence(v.native())
+	return gdk.WrapEventSequence(uintptr(unsafe.Pointer(c)))
+}
+
+/*
+ * GtkGestureDrag
+ */
+
+// GestureDrag is a representation of GTK's GtkGestureDrag.
+type GestureDrag struct {
+	GestureSingle
+}
+
+// native returns a pointer to the underlying GtkGestureDrag.
+func (v *GestureDrag) native() *C.GtkGestureDrag {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureDrag(p)
+}
+
+func marshalGestureDrag(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureDrag(obj), nil
+}
+
+func wrapGestureDrag(obj *glib.Object) *GestureDrag {
+	return &GestureDrag{GestureSingle{Gesture{EventController{obj}}}}
+}
+
+// GestureDragNew is a wrapper around gtk_gesture_drag_new().
+func GestureDragNew(widget IWidget) (*GestureDrag, error) {
+	c := C.gtk_gesture_drag_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGestureDrag(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetStartPoint is a wrapper around gtk_gesture_drag_get_start_point().
+func (v *GestureDrag) GetStartPoint() (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = gobool(C.gtk_gesture_drag_get_start_point(v.native(), &cx, &cy))
+	return float64(cx), float64(cy), ok
+}
+
+// GetOffset is a wrapper around gtk_gesture_drag_get_offset().
+func (v *GestureDrag) GetOffset() (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = gobool(C.gtk_gesture_drag_get_offset(v.native(), &cx, &cy))
+	return float64(cx), float64(cy), ok
+}
+
+// ConnectDragBegin connects f to the "drag-begin" signal, emitted with the
+// start point of the drag.
+func (v *GestureDrag) ConnectDragBegin(f func(startX, startY float64)) (glib.SignalHandle, error) {
+	return v.Connect("drag-begin", func(_ interface{}, startX, startY float64) {
+		f(startX, startY)
+	})
+}
+
+// ConnectDragUpdate connects f to the "drag-update" signal, emitted with
+// the offset from the start point whenever the drag moves.
+func (v *GestureDrag) ConnectDragUpdate(f func(offsetX, offsetY float64)) (glib.SignalHandle, error) {
+	return v.Connect("drag-update", func(_ interface{}, offsetX, offsetY float64) {
+		f(offsetX, offsetY)
+	})
+}
+
+// ConnectDragEnd connects f to the "drag-end" signal, emitted with the
+// final offset from the start point.
+func (v *GestureDrag) ConnectDragEnd(f func(offsetX, offsetY float64)) (glib.SignalHandle, error) {
+	return v.Connect("drag-end", func(_ interface{}, offsetX, offsetY float64) {
+		f(offsetX, offsetY)
+	})
+}
+
+/*
+ * GtkGestureLongPress
+ */
+
+// GestureLongPress is a representation of GTK's GtkGestureLongPress.
+type GestureLongPress struct {
+	GestureSingle
+}
+
+// native returns a pointer to the underlying GtkGestureLongPress.
+func (v *GestureLongPress) native() *C.GtkGestureLongPress {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureLongPress(p)
+}
+
+func marshalGestureLongPress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureLongPress(obj), nil
+}
+
+func wrapGestureLongPress(obj *glib.Object) *GestureLongPress {
+	return &GestureLongPress{GestureSingle{Gesture{EventController{obj}}}}
+}
+
+// GestureLongPressNew is a wrapper around gtk_gesture_long_press_new().
+func GestureLongPressNew(widget IWidget) (*GestureLongPress, error) {
+	c := C.gtk_gesture_long_press_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGestureLongPress(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// ConnectPressed connects f to the "pressed" signal, emitted with the
+// position of the press once it has been held long enough.
+func (v *GestureLongPress) ConnectPressed(f func(x, y float64)) (glib.SignalHandle, error) {
+	return v.Connect("pressed", func(_ interface{}, x, y float64) {
+		f(x, y)
+	})
+}
+
+// ConnectCancelled connects f to the "cancelled" signal, emitted when the
+// press is released or moved before it has been held long enough.
+func (v *GestureLongPress) ConnectCancelled(f func()) (glib.SignalHandle, error) {
+	return v.Connect("cancelled", func(_ interface{}) {
+		f()
+	})
+}
+
+/*
+ * GtkGestureMultiPress
+ */
+
+// GestureMultiPress is a representation of GTK's GtkGestureMultiPress.
+type GestureMultiPress struct {
+	GestureSingle
+}
+
+// native returns a pointer to the underlying GtkGestureMultiPress.
+func (v *GestureMultiPress) native() *C.GtkGestureMultiPress {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureMultiPress(p)
+}
+
+func marshalGestureMultiPress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureMultiPress(obj), nil
+}
+
+func wrapGestureMultiPress(obj *glib.Object) *GestureMultiPress {
+	return &GestureMultiPress{GestureSingle{Gesture{EventController{obj}}}}
+}
+
+// GestureMultiPressNew is a wrapper around gtk_gesture_multi_press_new().
+func GestureMultiPressNew(widget IWidget) (*GestureMultiPress, error) {
+	c := C.gtk_gesture_multi_press_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGestureMultiPress(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetArea is a wrapper around gtk_gesture_multi_press_get_area().
+func (v *GestureMultiPress) GetArea() (*gdk.Rectangle, bool) {
+	var crect C.GdkRectangle
+	ok := gobool(C.gtk_gesture_multi_press_get_area(v.native(), &crect))
+	if !ok {
+		return nil, false
+	}
+	return gdk.WrapRectangle(uintptr(unsafe.Pointer(&crect))), true
+}
+
+// SetArea is a wrapper around gtk_gesture_multi_press_set_area(). Passing
+// nil unsets the area.
+func (v *GestureMultiPress) SetArea(rect *gdk.Rectangle) {
+	var crect *C.GdkRectangle
+	if rect != nil {
+		crect = nativeGdkRectangle(*rect)
+	}
+	C.gtk_gesture_multi_press_set_area(v.native(), crect)
+}
+
+// ConnectPressed connects f to the "pressed" signal. nPress is the number
+// of presses in the current sequence, e.g. 2 for a double click.
+func (v *GestureMultiPress) ConnectPressed(f func(nPress int, x, y float64)) (glib.SignalHandle, error) {
+	return v.Connect("pressed", func(_ interface{}, nPress int, x, y float64) {
+		f(nPress, x, y)
+	})
+}
+
+// ConnectReleased connects f to the "released" signal.
+func (v *GestureMultiPress) ConnectReleased(f func(nPress int, x, y float64)) (glib.SignalHandle, error) {
+	return v.Connect("released", func(_ interface{}, nPress int, x, y float64) {
+		f(nPress, x, y)
+	})
+}
+
+// ConnectStopped connects f to the "stopped" signal, emitted when the
+// sequence of presses ends, e.g. after the double-click timeout.
+func (v *GestureMultiPress) ConnectStopped(f func()) (glib.SignalHandle, error) {
+	return v.Connect("stopped", func(_ interface{}) {
+		f()
+	})
+}
+
+/*
+ * GtkGesturePan
+ */
+
+// GesturePan is a representation of GTK's GtkGesturePan.
+type GesturePan struct {
+	GestureDrag
+}
+
+// native returns a pointer to the underlying GtkGesturePan.
+func (v *GesturePan) native() *C.GtkGesturePan {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGesturePan(p)
+}
+
+func marshalGesturePan(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGesturePan(obj), nil
+}
+
+func wrapGesturePan(obj *glib.Object) *GesturePan {
+	return &GesturePan{GestureDrag{GestureSingle{Gesture{EventController{obj}}}}}
+}
+
+// GesturePanNew is a wrapper around gtk_gesture_pan_new().
+func GesturePanNew(widget IWidget, orientation Orientation) (*GesturePan, error) {
+	c := C.gtk_gesture_pan_new(widget.toWidget(), C.GtkOrientation(orientation))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGesturePan(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetOrientation is a wrapper around gtk_gesture_pan_get_orientation().
+func (v *GesturePan) GetOrientation() Orientation {
+	return Orientation(C.gtk_gesture_pan_get_orientation(v.native()))
+}
+
+// SetOrientation is a wrapper around gtk_gesture_pan_set_orientation().
+func (v *GesturePan) SetOrientation(orientation Orientation) {
+	C.gtk_gesture_pan_set_orientation(v.native(), C.GtkOrientation(orientation))
+}
+
+// ConnectPan connects f to the "pan" signal, emitted with the direction
+// and the offset along the orientation of the gesture.
+func (v *GesturePan) ConnectPan(f func(direction PanDirection, offset float64)) (glib.SignalHandle, error) {
+	return v.Connect("pan", func(_ interface{}, direction PanDirection, offset float64) {
+		f(direction, offset)
+	})
+}
+
+/*
+ * GtkGestureSwipe
+ */
+
+// GestureSwipe is a representation of GTK's GtkGestureSwipe.
+type GestureSwipe struct {
+	GestureSingle
+}
+
+// native returns a pointer to the underlying GtkGestureSwipe.
+func (v *GestureSwipe) native() *C.GtkGestureSwipe {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureSwipe(p)
+}
+
+func marshalGestureSwipe(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureSwipe(obj), nil
+}
+
+func wrapGestureSwipe(obj *glib.Object) *GestureSwipe {
+	return &GestureSwipe{GestureSingle{Gesture{EventController{obj}}}}
+}
+
+// GestureSwipeNew is a wrapper around gtk_gesture_swipe_new().
+func GestureSwipeNew(widget IWidget) (*GestureSwipe, error) {
+	c := C.gtk_gesture_swipe_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGestureSwipe(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetVelocity is a wrapper around gtk_gesture_swipe_get_velocity().
+func (v *GestureSwipe) GetVelocity() (velocityX, velocityY float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = gobool(C.gtk_gesture_swipe_get_velocity(v.native(), &cx, &cy))
+	return float64(cx), float64(cy), ok
+}
+
+// ConnectSwipe connects f to the "swipe" signal, emitted with the velocity
+// in pixels per second when the swipe ends.
+func (v *GestureSwipe) ConnectSwipe(f func(velocityX, velocityY float64)) (glib.SignalHandle, error) {
+	return v.Connect("swipe", func(_ interface{}, velocityX, velocityY float64) {
+		f(velocityX, velocityY)
+	})
+}
+
+/*
+ * GtkGestureRotate
+ */
+
+// GestureRotate is a representation of GTK's GtkGestureRotate.
+type GestureRotate struct {
+	Gesture
+}
+
+// native returns a pointer to the underlying GtkGestureRotate.
+func (v *GestureRotate) native() *C.GtkGestureRotate {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureRotate(p)
+}
+
+func marshalGestureRotate(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureRotate(obj), nil
+}
+
+func wrapGestureRotate(obj *glib.Object) *GestureRotate {
+	return &GestureRotate{Gesture{EventController{obj}}}
+}
+
+// GestureRotateNew is a wrapper around gtk_gesture_rotate_new().
+func GestureRotateNew(widget IWidget) (*GestureRotate, error) {
+	c := C.gtk_gesture_rotate_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGestureRotate(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetAngleDelta is a wrapper around gtk_gesture_rotate_get_angle_delta().
+func (v *GestureRotate) GetAngleDelta() float64 {
+	return float64(C.gtk_gesture_rotate_get_angle_delta(v.native()))
+}
+
+// ConnectAngleChanged connects f to the "angle-changed" signal. Angles are
+// in radians.
+func (v *GestureRotate) ConnectAngleChanged(f func(angle, angleDelta float64)) (glib.SignalHandle, error) {
+	return v.Connect("angle-changed", func(_ interface{}, angle, angleDelta float64) {
+		f(angle, angleDelta)
+	})
+}
+
+/*
+ * GtkGestureZoom
+ */
+
+// GestureZoom is a representation of GTK's GtkGestureZoom.
+type GestureZoom struct {
+	Gesture
+}
+
+// native returns a pointer to the underlying GtkGestureZoom.
+func (v *GestureZoom) native() *C.GtkGestureZoom {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkGestureZoom(p)
+}
+
+func marshalGestureZoom(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGestureZoom(obj), nil
+}
+
+func wrapGestureZoom(obj *glib.Object) *GestureZoom {
+	return &GestureZoom{Gesture{EventController{obj}}}
+}
+
+// GestureZoomNew is a wrapper around gtk_gesture_zoom_new().
+func GestureZoomNew(widget IWidget) (*GestureZoom, error) {
+	c := C.gtk_gesture_zoom_new(widget.toWidget())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapGestureZoom(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetScaleDelta is a wrapper around gtk_gesture_zoom_get_scale_delta().
+func (v *GestureZoom) GetScaleDelta() float64 {
+	return float64(C.gtk_gesture_zoom_get_scale_delta(v.native()))
+}
+
+// ConnectScaleChanged connects f to the "scale-changed" signal, emitted
+// with the scale relative to the initial distance of the touch points.
+func (v *GestureZoom) ConnectScaleChanged(f func(scale float64)) (glib.SignalHandle, error) {
+	return v.Connect("scale-changed", func(_ interface{}, scale float64) {
+		f(scale)
+	})
+}
@@ -0,0 +1,61 @@
+// Same copyright and license as the rest of the files in this project
+
+static GtkEventController *
+toGtkEventController(void *p)
+{
+	return (GTK_EVENT_CONTROLLER(p));
+}
+
+static GtkGesture *
+toGtkGesture(void *p)
+{
+	return (GTK_GESTURE(p));
+}
+
+static GtkGestureSingle *
+toGtkGestureSingle(void *p)
+{
+	return (GTK_GESTURE_SINGLE(p));
+}
+
+static GtkGestureDrag *
+toGtkGestureDrag(void *p)
+{
+	return (GTK_GESTURE_DRAG(p));
+}
+
+static GtkGestureLongPress *
+toGtkGestureLongPress(void *p)
+{
+	return (GTK_GESTURE_LONG_PRESS(p));
+}
+
+static GtkGestureMultiPress *
+toGtkGestureMultiPress(void *p)
+{
+	return (GTK_GESTURE_MULTI_PRESS(p));
+}
+
+static GtkGesturePan *
+toGtkGesturePan(void *p)
+{
+	return (GTK_GESTURE_PAN(p));
+}
+
+static GtkGestureSwipe *
+toGtkGestureSwipe(void *p)
+{
+	return (GTK_GESTURE_SWIPE(p));
+}
+
+static GtkGestureRotate *
+toGtkGestureRotate(void *p)
+{
+	return (GTK_GESTURE_ROTATE(p));
+}
+
+static GtkGestureZoom *
+toGtkGestureZoom(void *p)
+{
+	return (GTK_GESTURE_ZOOM(p));
+}
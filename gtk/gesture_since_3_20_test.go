@@ -0,0 +1,362 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!gtk_3_18
+
+package gtk_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// gestureTestTarget is a realized widget and the pointer device used to
+// synthesize events for it.
+type gestureTestTarget struct {
+	area   *gtk.DrawingArea
+	window *gdk.Window
+	device *gdk.Device
+}
+
+func createGestureTestTarget(t *testing.T) *gestureTestTarget {
+	win := createTestWindow(t)
+	area, err := gtk.DrawingAreaNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	area.SetSizeRequest(100, 100)
+	win.Add(area)
+	area.Realize()
+
+	window, err := area.GetWindow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	display, err := gdk.DisplayGetDefault()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seat, err := display.GetDefaultSeat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	device, err := seat.GetPointer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &gestureTestTarget{area, window, device}
+}
+
+func (tt *gestureTestTarget) event(typ gdk.EventType) *gdk.Event {
+	e := gdk.EventNew(typ)
+	e.SetWindow(tt.window)
+	e.SetDevice(tt.device)
+	e.SetSourceDevice(tt.device)
+	return e
+}
+
+func (tt *gestureTestTarget) button(typ gdk.EventType, x, y float64, time uint32) *gdk.Event {
+	e := tt.event(typ)
+	b := gdk.EventButtonNewFromEvent(e)
+	b.SetButton(gdk.BUTTON_PRIMARY)
+	b.SetMotionVal(x, y)
+	b.SetTime(time)
+	if typ == gdk.EVENT_BUTTON_RELEASE {
+		b.SetState(gdk.BUTTON1_MASK)
+	}
+	return e
+}
+
+func (tt *gestureTestTarget) motion(x, y float64, time uint32) *gdk.Event {
+	e := tt.event(gdk.EVENT_MOTION_NOTIFY)
+	m := gdk.EventMotionNewFromEvent(e)
+	m.SetMotionVal(x, y)
+	m.SetState(gdk.BUTTON1_MASK)
+	m.SetTime(time)
+	return e
+}
+
+// touch returns a touch event of the sequence numbered seq. Sequences are
+// opaque identifiers, so made-up pointers past the first page will do.
+func (tt *gestureTestTarget) touch(typ gdk.EventType, seq int, x, y float64, time uint32) *gdk.Event {
+	e := tt.event(typ)
+	te := gdk.EventTouchNewFromEvent(e)
+	te.SetSequence(gdk.WrapEventSequence(uintptr(seq) << 12))
+	te.SetMotionVal(x, y)
+	te.SetTime(time)
+	return e
+}
+
+// iterateUntil runs the main loop until done returns true, or two seconds
+// have passed.
+func iterateUntil(done func() bool) {
+	for deadline := time.Now().Add(2 * time.Second); !done() && time.Now().Before(deadline); {
+		gtk.MainIterationDo(false)
+	}
+}
+
+func TestGestureMultiPress(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GestureMultiPressNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pressed, released int
+	gesture.ConnectPressed(func(nPress int, x, y float64) {
+		pressed = nPress
+		if x != 10 || y != 20 {
+			t.Errorf("unexpected press position %v,%v", x, y)
+		}
+	})
+	gesture.ConnectReleased(func(nPress int, x, y float64) {
+		released = nPress
+	})
+
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_PRESS, 10, 20, 1))
+	if !gesture.IsRecognized() {
+		t.Error("expected the gesture to be recognized after a press")
+	}
+	if b := gesture.GetCurrentButton(); b != uint(gdk.BUTTON_PRIMARY) {
+		t.Errorf("expected current button %d, got %d", gdk.BUTTON_PRIMARY, b)
+	}
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_RELEASE, 10, 20, 2))
+
+	if pressed != 1 || released != 1 {
+		t.Errorf("expected one press and release, got %d and %d", pressed, released)
+	}
+}
+
+func TestGestureDrag(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GestureDragNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var began bool
+	var offsetX, offsetY float64
+	gesture.ConnectDragBegin(func(startX, startY float64) {
+		began = startX == 10 && startY == 10
+	})
+	gesture.ConnectDragEnd(func(x, y float64) {
+		offsetX, offsetY = x, y
+	})
+
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_PRESS, 10, 10, 1))
+	gesture.HandleEvent(tt.motion(30, 25, 2))
+	if x, y, ok := gesture.GetOffset(); !ok || x != 20 || y != 15 {
+		t.Errorf("expected offset 20,15 during the drag, got %v,%v (%v)", x, y, ok)
+	}
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_RELEASE, 30, 25, 3))
+
+	if !began {
+		t.Error("expected drag-begin at 10,10")
+	}
+	if offsetX != 20 || offsetY != 15 {
+		t.Errorf("expected drag-end offset 20,15, got %v,%v", offsetX, offsetY)
+	}
+}
+
+func TestGestureGroupAndPhase(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	press, err := gtk.GestureMultiPressNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drag, err := gtk.GestureDragNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drag.Group(press)
+	if !drag.IsGroupedWith(press) || !press.IsGroupedWith(drag) {
+		t.Error("expected gestures to be grouped")
+	}
+	group, err := press.GetGroup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(group) != 2 {
+		t.Errorf("expected a group of 2 gestures, got %d", len(group))
+	}
+	for _, g := range group {
+		if _, ok := g.(*gtk.GestureMultiPress); ok {
+			continue
+		}
+		if _, ok := g.(*gtk.GestureDrag); !ok {
+			t.Errorf("unexpected gesture type %T in group", g)
+		}
+	}
+	drag.Ungroup()
+	if drag.IsGroupedWith(press) {
+		t.Error("expected gestures to be ungrouped")
+	}
+
+	press.SetPropagationPhase(gtk.PHASE_CAPTURE)
+	if p := press.GetPropagationPhase(); p != gtk.PHASE_CAPTURE {
+		t.Errorf("expected capture phase, got %v", p)
+	}
+
+	w, err := press.GetWidget()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.ToWidget().Native() != tt.area.Native() {
+		t.Error("expected the gesture widget to be the drawing area")
+	}
+}
+
+func TestGestureLongPress(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GestureLongPressNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pressed, cancelled bool
+	gesture.ConnectPressed(func(x, y float64) {
+		pressed = true
+		if x != 10 || y != 20 {
+			t.Errorf("unexpected long press position %v,%v", x, y)
+		}
+	})
+	gesture.ConnectCancelled(func() {
+		cancelled = true
+	})
+
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_PRESS, 10, 20, 1))
+	iterateUntil(func() bool { return pressed })
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_RELEASE, 10, 20, 2))
+	if !pressed || cancelled {
+		t.Errorf("expected a long press, got pressed %v and cancelled %v", pressed, cancelled)
+	}
+
+	// Moving away before the delay cancels the long press.
+	pressed = false
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_PRESS, 10, 20, 3))
+	gesture.HandleEvent(tt.motion(60, 20, 4))
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_RELEASE, 60, 20, 5))
+	if pressed || !cancelled {
+		t.Errorf("expected a cancelled long press, got pressed %v and cancelled %v", pressed, cancelled)
+	}
+}
+
+func TestGestureSwipe(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GestureSwipeNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var swiped bool
+	var velocityX, velocityY float64
+	gesture.ConnectSwipe(func(vx, vy float64) {
+		swiped = true
+		velocityX, velocityY = vx, vy
+	})
+
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_PRESS, 10, 50, 1000))
+	gesture.HandleEvent(tt.motion(20, 50, 1010))
+	gesture.HandleEvent(tt.motion(40, 50, 1020))
+	if vx, vy, ok := gesture.GetVelocity(); !ok || vx <= 0 || vy != 0 {
+		t.Errorf("expected a velocity to the right during the swipe, got %v,%v (%v)", vx, vy, ok)
+	}
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_RELEASE, 50, 50, 1030))
+
+	if !swiped {
+		t.Fatal("expected a swipe")
+	}
+	if velocityX <= 0 || velocityY != 0 {
+		t.Errorf("expected a swipe to the right, got the velocity %v,%v", velocityX, velocityY)
+	}
+}
+
+func TestGesturePan(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GesturePanNew(tt.area, gtk.ORIENTATION_HORIZONTAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var direction gtk.PanDirection
+	var offset float64
+	gesture.ConnectPan(func(d gtk.PanDirection, o float64) {
+		direction, offset = d, o
+	})
+
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_PRESS, 10, 10, 1))
+	gesture.HandleEvent(tt.motion(40, 12, 2))
+	gesture.HandleEvent(tt.button(gdk.EVENT_BUTTON_RELEASE, 40, 12, 3))
+
+	if direction != gtk.PAN_DIRECTION_RIGHT || offset != 30 {
+		t.Errorf("expected a pan of 30 to the right, got %v towards %v", offset, direction)
+	}
+}
+
+func TestGestureRotate(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GestureRotateNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var changed bool
+	gesture.ConnectAngleChanged(func(angle, angleDelta float64) {
+		changed = true
+	})
+
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_BEGIN, 1, 20, 50, 1))
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_BEGIN, 2, 80, 50, 2))
+	if !gesture.IsRecognized() {
+		t.Error("expected the gesture to be recognized with two touches")
+	}
+	// A quarter turn of the second touch around the first one.
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_UPDATE, 2, 20, 110, 3))
+
+	if !changed {
+		t.Error("expected angle-changed")
+	}
+	delta := gesture.GetAngleDelta()
+	if math.Abs(math.Cos(delta)) > 1e-6 || math.Abs(math.Abs(math.Sin(delta))-1) > 1e-6 {
+		t.Errorf("expected a quarter turn, got an angle delta of %v", delta)
+	}
+
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_END, 2, 20, 110, 4))
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_END, 1, 20, 50, 5))
+	if gesture.IsActive() {
+		t.Error("expected the gesture to end with the touches")
+	}
+}
+
+func TestGestureZoom(t *testing.T) {
+	tt := createGestureTestTarget(t)
+	gesture, err := gtk.GestureZoomNew(tt.area)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var scale float64
+	gesture.ConnectScaleChanged(func(s float64) {
+		scale = s
+	})
+
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_BEGIN, 1, 20, 50, 1))
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_BEGIN, 2, 40, 50, 2))
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_UPDATE, 2, 60, 50, 3))
+
+	if math.Abs(scale-2) > 1e-6 {
+		t.Errorf("expected the scale to double, got %v", scale)
+	}
+	if d := gesture.GetScaleDelta(); math.Abs(d-2) > 1e-6 {
+		t.Errorf("expected a scale delta of 2, got %v", d)
+	}
+
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_END, 2, 60, 50, 4))
+	gesture.HandleEvent(tt.touch(gdk.EVENT_TOUCH_END, 1, 20, 50, 5))
+}
@@ -25,7 +25,6 @@ package gtk
 // #include "gtk_since_3_16.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 
 	"github.com/gotk3/gotk3/gdk"
@@ -171,9 +170,6 @@ func (v *GLArea) AttachBuffers() {
 func (v *GLArea) GetError() error {
 	var err *C.GError = nil
 	err = C.gtk_gl_area_get_error(v.native())
-	if err != nil {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
-	}
-	return nil
+	// The GError is owned by the area, so it is copied but not freed.
+	return glib.WrapError(unsafe.Pointer(err))
 }
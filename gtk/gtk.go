@@ -82,6 +82,7 @@ func init() {
 		{glib.Type(C.gtk_file_chooser_action_get_type()), marshalFileChooserAction},
 		{glib.Type(C.gtk_icon_lookup_flags_get_type()), marshalSortType},
 		{glib.Type(C.gtk_icon_size_get_type()), marshalIconSize},
+		{glib.Type(C.gtk_icon_view_drop_position_get_type()), marshalIconViewDropPosition},
 		{glib.Type(C.gtk_image_type_get_type()), marshalImageType},
 		{glib.Type(C.gtk_input_hints_get_type()), marshalInputHints},
 		{glib.Type(C.gtk_input_purpose_get_type()), marshalInputPurpose},
@@ -98,6 +99,7 @@ func init() {
 		{glib.Type(C.gtk_response_type_get_type()), marshalResponseType},
 		{glib.Type(C.gtk_selection_mode_get_type()), marshalSelectionMode},
 		{glib.Type(C.gtk_shadow_type_get_type()), marshalShadowType},
+		{glib.Type(C.gtk_size_request_mode_get_type()), marshalSizeRequestMode},
 		{glib.Type(C.gtk_sort_type_get_type()), marshalSortType},
 		{glib.Type(C.gtk_state_flags_get_type()), marshalStateFlags},
 		{glib.Type(C.gtk_target_flags_get_type()), marshalTargetFlags},
@@ -105,6 +107,7 @@ func init() {
 		{glib.Type(C.gtk_text_search_flags_get_type()), marshalTextSearchFlags},
 		{glib.Type(C.gtk_toolbar_style_get_type()), marshalToolbarStyle},
 		{glib.Type(C.gtk_tree_model_flags_get_type()), marshalTreeModelFlags},
+		{glib.Type(C.gtk_tree_view_drop_position_get_type()), marshalTreeViewDropPosition},
 		{glib.Type(C.gtk_window_position_get_type()), marshalWindowPosition},
 		{glib.Type(C.gtk_window_type_get_type()), marshalWindowType},
 		{glib.Type(C.gtk_wrap_mode_get_type()), marshalWrapMode},
@@ -492,6 +495,23 @@ func marshalIconSize(p uintptr) (interface{}, error) {
 	return IconSize(c), nil
 }
 
+// IconViewDropPosition is a representation of GTK's GtkIconViewDropPosition.
+type IconViewDropPosition int
+
+const (
+	ICON_VIEW_NO_DROP    IconViewDropPosition = C.GTK_ICON_VIEW_NO_DROP
+	ICON_VIEW_DROP_INTO  IconViewDropPosition = C.GTK_ICON_VIEW_DROP_INTO
+	ICON_VIEW_DROP_LEFT  IconViewDropPosition = C.GTK_ICON_VIEW_DROP_LEFT
+	ICON_VIEW_DROP_RIGHT IconViewDropPosition = C.GTK_ICON_VIEW_DROP_RIGHT
+	ICON_VIEW_DROP_ABOVE IconViewDropPosition = C.GTK_ICON_VIEW_DROP_ABOVE
+	ICON_VIEW_DROP_BELOW IconViewDropPosition = C.GTK_ICON_VIEW_DROP_BELOW
+)
+
+func marshalIconViewDropPosition(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return IconViewDropPosition(c), nil
+}
+
 // ImageType is a representation of GTK's GtkImageType.
 type ImageType int
 
@@ -701,6 +721,21 @@ const (
 	TREE_VIEW_GRID_LINES_BOTH       TreeViewGridLines = C.GTK_TREE_VIEW_GRID_LINES_BOTH
 )
 
+// TreeViewDropPosition is a representation of GTK's GtkTreeViewDropPosition.
+type TreeViewDropPosition int
+
+const (
+	TREE_VIEW_DROP_BEFORE         TreeViewDropPosition = C.GTK_TREE_VIEW_DROP_BEFORE
+	TREE_VIEW_DROP_AFTER          TreeViewDropPosition = C.GTK_TREE_VIEW_DROP_AFTER
+	TREE_VIEW_DROP_INTO_OR_BEFORE TreeViewDropPosition = C.GTK_TREE_VIEW_DROP_INTO_OR_BEFORE
+	TREE_VIEW_DROP_INTO_OR_AFTER  TreeViewDropPosition = C.GTK_TREE_VIEW_DROP_INTO_OR_AFTER
+)
+
+func marshalTreeViewDropPosition(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return TreeViewDropPosition(c), nil
+}
+
 // CellRendererState is a representation of GTK's GtkCellRendererState
 type CellRendererState int
 
@@ -813,6 +848,20 @@ func marshalSizeGroupMode(p uintptr) (interface{}, error) {
 	return SizeGroupMode(c), nil
 }
 
+// SizeRequestMode is a representation of GTK's GtkSizeRequestMode.
+type SizeRequestMode int
+
+const (
+	SIZE_REQUEST_HEIGHT_FOR_WIDTH SizeRequestMode = C.GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH
+	SIZE_REQUEST_WIDTH_FOR_HEIGHT SizeRequestMode = C.GTK_SIZE_REQUEST_WIDTH_FOR_HEIGHT
+	SIZE_REQUEST_CONSTANT_SIZE    SizeRequestMode = C.GTK_SIZE_REQUEST_CONSTANT_SIZE
+)
+
+func marshalSizeRequestMode(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SizeRequestMode(c), nil
+}
+
 // SortType is a representation of GTK's GtkSortType.
 type SortType int
 
@@ -1431,6 +1480,27 @@ func marshalBuilder(p uintptr) (interface{}, error) {
 	return &Builder{obj}, nil
 }
 
+// BuilderError is a representation of GTK's GtkBuilderError.
+type BuilderError int
+
+const (
+	BUILDER_ERROR_INVALID_TYPE_FUNCTION  BuilderError = C.GTK_BUILDER_ERROR_INVALID_TYPE_FUNCTION
+	BUILDER_ERROR_UNHANDLED_TAG          BuilderError = C.GTK_BUILDER_ERROR_UNHANDLED_TAG
+	BUILDER_ERROR_MISSING_ATTRIBUTE      BuilderError = C.GTK_BUILDER_ERROR_MISSING_ATTRIBUTE
+	BUILDER_ERROR_INVALID_ATTRIBUTE      BuilderError = C.GTK_BUILDER_ERROR_INVALID_ATTRIBUTE
+	BUILDER_ERROR_INVALID_TAG            BuilderError = C.GTK_BUILDER_ERROR_INVALID_TAG
+	BUILDER_ERROR_MISSING_PROPERTY_VALUE BuilderError = C.GTK_BUILDER_ERROR_MISSING_PROPERTY_VALUE
+	BUILDER_ERROR_INVALID_VALUE          BuilderError = C.GTK_BUILDER_ERROR_INVALID_VALUE
+	BUILDER_ERROR_VERSION_MISMATCH       BuilderError = C.GTK_BUILDER_ERROR_VERSION_MISMATCH
+	BUILDER_ERROR_DUPLICATE_ID           BuilderError = C.GTK_BUILDER_ERROR_DUPLICATE_ID
+)
+
+// BuilderErrorQuark is a wrapper around gtk_builder_error_quark(), the
+// GTK_BUILDER_ERROR domain.
+func BuilderErrorQuark() glib.Quark {
+	return glib.Quark(C.gtk_builder_error_quark())
+}
+
 // BuilderNew is a wrapper around gtk_builder_new().
 func BuilderNew() (*Builder, error) {
 	c := C.gtk_builder_new()
@@ -1448,8 +1518,7 @@ func (b *Builder) AddFromFile(filename string) error {
 	var err *C.GError = nil
 	res := C.gtk_builder_add_from_file(b.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1461,8 +1530,7 @@ func (b *Builder) AddFromResource(path string) error {
 	var err *C.GError = nil
 	res := C.gtk_builder_add_from_resource(b.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1475,12 +1543,28 @@ func (b *Builder) AddFromString(str string) error {
 	var err *C.GError = nil
 	res := C.gtk_builder_add_from_string(b.native(), (*C.gchar)(cstr), length, &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
 
+// SetTranslationDomain is a wrapper around gtk_builder_set_translation_domain().
+// Translatable strings in objects added afterwards are looked up in domain.
+// An empty domain uses the domain set with textdomain().
+func (b *Builder) SetTranslationDomain(domain string) {
+	var cstr *C.gchar
+	if domain != "" {
+		cstr = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cstr))
+	}
+	C.gtk_builder_set_translation_domain(b.native(), cstr)
+}
+
+// GetTranslationDomain is a wrapper around gtk_builder_get_translation_domain().
+func (b *Builder) GetTranslationDomain() string {
+	return goString(C.gtk_builder_get_translation_domain(b.native()))
+}
+
 // GetObject is a wrapper around gtk_builder_get_object(). The returned result
 // is an IObject, so it will need to be type-asserted to the appropriate type before
 // being used. For example, to get an object and type assert it as a window:
@@ -1510,22 +1594,41 @@ func (b *Builder) GetObject(name string) (glib.IObject, error) {
 	return obj, nil
 }
 
+// builderConnection holds the handlers passed to ConnectSignals and the
+// handler names of the XML missing from them.
+type builderConnection struct {
+	handlers map[string]interface{}
+	missing  []string
+}
+
 var (
 	builderSignals = struct {
 		sync.RWMutex
-		m map[*C.GtkBuilder]map[string]interface{}
+		m map[*C.GtkBuilder]*builderConnection
 	}{
-		m: make(map[*C.GtkBuilder]map[string]interface{}),
+		m: make(map[*C.GtkBuilder]*builderConnection),
 	}
 )
 
 // ConnectSignals is a wrapper around gtk_builder_connect_signals_full().
-func (b *Builder) ConnectSignals(signals map[string]interface{}) {
+// It returns a *MissingHandlersError listing the handler names used in the
+// XML but not found in signals.
+func (b *Builder) ConnectSignals(signals map[string]interface{}) error {
+	conn := &builderConnection{handlers: signals}
 	builderSignals.Lock()
-	builderSignals.m[b.native()] = signals
+	builderSignals.m[b.native()] = conn
 	builderSignals.Unlock()
 
 	C._gtk_builder_connect_signals_full(b.native())
+
+	builderSignals.Lock()
+	delete(builderSignals.m, b.native())
+	builderSignals.Unlock()
+
+	if len(conn.missing) > 0 {
+		return &MissingHandlersError{conn.missing}
+	}
+	return nil
 }
 
 /*
@@ -2045,6 +2148,48 @@ func (v *CellLayout) AddAttribute(cell ICellRenderer, attribute string, column i
 		(*C.gchar)(cstr), C.gint(column))
 }
 
+// CellLayoutDataFunc is the function called by a CellLayout to set the
+// properties of cell for the row of model at iter, instead of or in
+// addition to its attributes. See CellLayout.SetCellDataFunc.
+type CellLayoutDataFunc func(layout *CellLayout, cell *CellRenderer, model *TreeModel, iter *TreeIter)
+
+var (
+	// cellDataFuncRegistry holds the cell data functions of tree view
+	// columns and cell layouts, adapted to the GObject they are set on. They
+	// are removed once GTK destroys them.
+	cellDataFuncRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]func(obj *glib.Object, cell *CellRenderer, model *TreeModel, iter *TreeIter)
+	}{
+		next: 1,
+		m:    make(map[int]func(*glib.Object, *CellRenderer, *TreeModel, *TreeIter)),
+	}
+)
+
+func registerCellDataFunc(f func(*glib.Object, *CellRenderer, *TreeModel, *TreeIter)) C.gpointer {
+	cellDataFuncRegistry.Lock()
+	id := cellDataFuncRegistry.next
+	cellDataFuncRegistry.next++
+	cellDataFuncRegistry.m[id] = f
+	cellDataFuncRegistry.Unlock()
+	return C.gpointer(uintptr(id))
+}
+
+// SetCellDataFunc is a wrapper around gtk_cell_layout_set_cell_data_func().
+// f is kept until it is replaced, or the layout is destroyed. A nil f
+// removes the current function.
+func (v *CellLayout) SetCellDataFunc(cell ICellRenderer, f CellLayoutDataFunc) {
+	if f == nil {
+		C.gtk_cell_layout_set_cell_data_func(v.native(), cell.toCellRenderer(), nil, nil, nil)
+		return
+	}
+	id := registerCellDataFunc(func(obj *glib.Object, cell *CellRenderer, model *TreeModel, iter *TreeIter) {
+		f(wrapCellLayout(obj), cell, model, iter)
+	})
+	C._gtk_cell_layout_set_cell_data_func(v.native(), cell.toCellRenderer(), id)
+}
+
 /*
  * GtkCellView
  */
@@ -2066,16 +2211,6 @@ func (v *CellLayout) AddAttribute(cell ICellRenderer, attribute string, column i
 // gtk_cell_view_set_fit_model().
 // gtk_cell_view_get_fit_model().
 
-/*
- * GtkCellEditable
- */
-
-// TODO:
-// GtkCellEditableIface
-// gtk_cell_editable_start_editing().
-// gtk_cell_editable_editing_done().
-// gtk_cell_editable_remove_widget().
-
 /*
  * GtkCellRenderer
  */
@@ -2882,8 +3017,7 @@ func (v *CssProvider) LoadFromPath(path string) error {
 	defer C.free(unsafe.Pointer(cpath))
 	var gerr *C.GError
 	if C.gtk_css_provider_load_from_path(v.native(), (*C.gchar)(cpath), &gerr) == 0 {
-		defer C.g_error_free(gerr)
-		return errors.New(goString(gerr.message))
+		return glib.TakeError(unsafe.Pointer(gerr))
 	}
 	return nil
 }
@@ -2894,8 +3028,7 @@ func (v *CssProvider) LoadFromData(data string) error {
 	defer C.free(unsafe.Pointer(cdata))
 	var gerr *C.GError
 	if C.gtk_css_provider_load_from_data(v.native(), (*C.gchar)(unsafe.Pointer(cdata)), C.gssize(len(data)), &gerr) == 0 {
-		defer C.g_error_free(gerr)
-		return errors.New(goString(gerr.message))
+		return glib.TakeError(unsafe.Pointer(gerr))
 	}
 	return nil
 }
@@ -3243,6 +3376,13 @@ func (v *Entry) toEntry() *C.GtkEntry {
 	return v.native()
 }
 
+func (v *Entry) toCellEditable() *C.GtkCellEditable {
+	if v == nil {
+		return nil
+	}
+	return C.toGtkCellEditable(unsafe.Pointer(v.GObject))
+}
+
 // native returns a pointer to the underlying GtkEntry.
 func (v *Entry) native() *C.GtkEntry {
 	if v == nil || v.GObject == nil {
@@ -3672,12 +3812,11 @@ func (v *Entry) GetIconTooltipMarkup(iconPos EntryIconPosition) (string, error)
 	return goString(c), nil
 }
 
-// TODO: depends on GtkTargetList
 // SetIconDragSource is a wrapper around gtk_entry_set_icon_drag_source().
-// func (v *Entry) SetIconDragSource(iconPos EntryIconPosition, targetList *TargetList, action gdk.DragAction) {
-// 	C.gtk_entry_set_icon_drag_source(v.native(), C.GtkEntryIconPosition(iconPos),
-// 		targetList.native(), C.GdkDragAction(action))
-// }
+func (v *Entry) SetIconDragSource(iconPos EntryIconPosition, targetList *TargetList, action gdk.DragAction) {
+	C.gtk_entry_set_icon_drag_source(v.native(), C.GtkEntryIconPosition(iconPos),
+		targetList.native(), C.GdkDragAction(action))
+}
 
 // GetCurrentIconDragSource is a wrapper around gtk_entry_get_current_icon_drag_source().
 func (v *Entry) GetCurrentIconDragSource() int {
@@ -4929,8 +5068,7 @@ func (v *IconTheme) LoadIcon(iconName string, size int, flags IconLookupFlags) (
 	var err *C.GError = nil
 	c := C.gtk_icon_theme_load_icon(v.Theme, (*C.gchar)(cstr), C.gint(size), C.GtkIconLookupFlags(flags), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}, nil
 }
@@ -5337,6 +5475,8 @@ type ListStore struct {
 	// Interfaces
 	TreeModel
 	TreeSortable
+	TreeDragSource
+	TreeDragDest
 }
 
 // native returns a pointer to the underlying GtkListStore.
@@ -5357,7 +5497,7 @@ func marshalListStore(p uintptr) (interface{}, error) {
 func wrapListStore(obj *glib.Object) *ListStore {
 	tm := wrapTreeModel(obj)
 	ts := wrapTreeSortable(obj)
-	return &ListStore{obj, *tm, *ts}
+	return &ListStore{obj, *tm, *ts, TreeDragSource{obj}, TreeDragDest{obj}}
 }
 
 func (v *ListStore) toTreeModel() *C.GtkTreeModel {
@@ -7464,7 +7604,15 @@ type SelectionData struct {
 
 func marshalSelectionData(p uintptr) (interface{}, error) {
 	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
-	return (*SelectionData)(unsafe.Pointer(c)), nil
+	return &SelectionData{(*C.GtkSelectionData)(unsafe.Pointer(c))}, nil
+}
+
+// WrapSelectionData wraps a pointer to a GtkSelectionData owned by GTK,
+// such as the uintptr passed to "drag-data-get" and "drag-data-received"
+// handlers. The selection data is not freed by the wrapper and must not be
+// used after the handler returns, see Copy.
+func WrapSelectionData(p uintptr) *SelectionData {
+	return &SelectionData{(*C.GtkSelectionData)(unsafe.Pointer(p))}
 }
 
 // native returns a pointer to the underlying GtkSelectionData.
@@ -7492,6 +7640,159 @@ func (v *SelectionData) GetData() (data []byte) {
 	return
 }
 
+// Copy is a wrapper around gtk_selection_data_copy(). Unlike the selection
+// data passed to signal handlers, the copy may be kept after the handler
+// returns.
+func (v *SelectionData) Copy() (*SelectionData, error) {
+	c := C.gtk_selection_data_copy(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	p := &SelectionData{c}
+	runtime.SetFinalizer(p, (*SelectionData).free)
+	return p, nil
+}
+
+// GetSelection is a wrapper around gtk_selection_data_get_selection().
+func (v *SelectionData) GetSelection() gdk.Atom {
+	c := C.gtk_selection_data_get_selection(v.native())
+	return gdk.Atom(uintptr(unsafe.Pointer(c)))
+}
+
+// GetTarget is a wrapper around gtk_selection_data_get_target().
+func (v *SelectionData) GetTarget() gdk.Atom {
+	c := C.gtk_selection_data_get_target(v.native())
+	return gdk.Atom(uintptr(unsafe.Pointer(c)))
+}
+
+// GetDataType is a wrapper around gtk_selection_data_get_data_type().
+func (v *SelectionData) GetDataType() gdk.Atom {
+	c := C.gtk_selection_data_get_data_type(v.native())
+	return gdk.Atom(uintptr(unsafe.Pointer(c)))
+}
+
+// GetFormat is a wrapper around gtk_selection_data_get_format(). The
+// format is the number of bits per unit of data, usually 8.
+func (v *SelectionData) GetFormat() int {
+	return int(C.gtk_selection_data_get_format(v.native()))
+}
+
+// Set is a wrapper around gtk_selection_data_set(). It stores data of
+// dataType, for instance a custom MIME type, in units of format bits. The
+// data is copied.
+func (v *SelectionData) Set(dataType gdk.Atom, format int, data []byte) {
+	var cdata *C.guchar
+	if len(data) > 0 {
+		cdata = (*C.guchar)(unsafe.Pointer(&data[0]))
+	}
+	C.gtk_selection_data_set(v.native(), C.GdkAtom(unsafe.Pointer(dataType)), C.gint(format),
+		cdata, C.gint(len(data)))
+}
+
+// SetText is a wrapper around gtk_selection_data_set_text(). It returns
+// false if the target of the selection is not a text target.
+func (v *SelectionData) SetText(text string) bool {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	return gobool(C.gtk_selection_data_set_text(v.native(), (*C.gchar)(cstr), C.gint(len(text))))
+}
+
+// GetText is a wrapper around gtk_selection_data_get_text(). It returns
+// false if the selection does not hold text.
+func (v *SelectionData) GetText() (string, bool) {
+	c := C.gtk_selection_data_get_text(v.native())
+	if c == nil {
+		return "", false
+	}
+	defer C.g_free(C.gpointer(unsafe.Pointer(c)))
+	return C.GoString((*C.char)(unsafe.Pointer(c))), true
+}
+
+// SetURIs is a wrapper around gtk_selection_data_set_uris(). It returns
+// false if the target of the selection is not "text/uri-list".
+func (v *SelectionData) SetURIs(uris []string) bool {
+	curis := C.make_strings(C.int(len(uris) + 1))
+	for i, uri := range uris {
+		cstr := C.CString(uri)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(curis, C.int(i), (*C.gchar)(cstr))
+	}
+	C.set_string(curis, C.int(len(uris)), nil)
+	defer C.destroy_strings(curis)
+
+	return gobool(C.gtk_selection_data_set_uris(v.native(), curis))
+}
+
+// GetURIs is a wrapper around gtk_selection_data_get_uris(). It returns nil
+// if the selection does not hold a URI list, such as files dragged from a
+// file manager.
+func (v *SelectionData) GetURIs() []string {
+	c := C.gtk_selection_data_get_uris(v.native())
+	if c == nil {
+		return nil
+	}
+	return toGoStringArray(c)
+}
+
+// SetPixbuf is a wrapper around gtk_selection_data_set_pixbuf(). It
+// returns false if the target of the selection is not an image target.
+func (v *SelectionData) SetPixbuf(pixbuf *gdk.Pixbuf) bool {
+	return gobool(C.gtk_selection_data_set_pixbuf(v.native(),
+		(*C.GdkPixbuf)(unsafe.Pointer(pixbuf.Native()))))
+}
+
+// GetPixbuf is a wrapper around gtk_selection_data_get_pixbuf().
+func (v *SelectionData) GetPixbuf() (*gdk.Pixbuf, error) {
+	c := C.gtk_selection_data_get_pixbuf(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	// Take added its own reference to the one returned.
+	C.g_object_unref(C.gpointer(c))
+	return &gdk.Pixbuf{obj}, nil
+}
+
+// GetTargets is a wrapper around gtk_selection_data_get_targets(). It
+// returns the targets held by a selection of the "TARGETS" type, and false
+// if the selection is of another type.
+func (v *SelectionData) GetTargets() ([]gdk.Atom, bool) {
+	var (
+		catoms *C.GdkAtom
+		n      C.gint
+	)
+	if !gobool(C.gtk_selection_data_get_targets(v.native(), &catoms, &n)) {
+		return nil, false
+	}
+	defer C.g_free(C.gpointer(unsafe.Pointer(catoms)))
+
+	atoms := make([]gdk.Atom, int(n))
+	for i, a := range (*[1 << 20]C.GdkAtom)(unsafe.Pointer(catoms))[:n:n] {
+		atoms[i] = gdk.Atom(uintptr(unsafe.Pointer(a)))
+	}
+	return atoms, true
+}
+
+// TargetsIncludeText is a wrapper around gtk_selection_data_targets_include_text().
+func (v *SelectionData) TargetsIncludeText() bool {
+	return gobool(C.gtk_selection_data_targets_include_text(v.native()))
+}
+
+// TargetsIncludeRichText is a wrapper around gtk_selection_data_targets_include_rich_text().
+func (v *SelectionData) TargetsIncludeRichText(buffer *TextBuffer) bool {
+	return gobool(C.gtk_selection_data_targets_include_rich_text(v.native(), buffer.native()))
+}
+
+// TargetsIncludeImage is a wrapper around gtk_selection_data_targets_include_image().
+func (v *SelectionData) TargetsIncludeImage(writable bool) bool {
+	return gobool(C.gtk_selection_data_targets_include_image(v.native(), gbool(writable)))
+}
+
+// TargetsIncludeURI is a wrapper around gtk_selection_data_targets_include_uri().
+func (v *SelectionData) TargetsIncludeURI() bool {
+	return gobool(C.gtk_selection_data_targets_include_uri(v.native()))
+}
+
 //fixed GetData directly from ptr
 func GetData(pointer uintptr) (data []byte) {
 	c := (*C.GValue)(unsafe.Pointer(pointer))
@@ -8480,8 +8781,7 @@ func (v *TextBuffer) Deserialize(contentBuffer *TextBuffer, format gdk.Atom, ite
 	cbool := C.gtk_text_buffer_deserialize(v.native(), contentBuffer.native(), C.GdkAtom(unsafe.Pointer(format)),
 		(*C.GtkTextIter)(iter), (*C.guint8)(unsafe.Pointer(&data[0])), length, &cerr)
 	if !gobool(cbool) {
-		defer C.g_error_free(cerr)
-		return false, errors.New(goString(cerr.message))
+		return false, glib.TakeError(unsafe.Pointer(cerr))
 	}
 	return gobool(cbool), nil
 }
@@ -9417,6 +9717,41 @@ func (v *TreeModel) ForEach(f TreeModelForeachFunc, userData ...interface{}) {
 	treeModelForeachFuncRegistry.Unlock()
 }
 
+// RowChanged is a wrapper around gtk_tree_model_row_changed().
+func (v *TreeModel) RowChanged(path *TreePath, iter *TreeIter) {
+	C.gtk_tree_model_row_changed(v.native(), path.native(), iter.native())
+}
+
+// RowInserted is a wrapper around gtk_tree_model_row_inserted().
+func (v *TreeModel) RowInserted(path *TreePath, iter *TreeIter) {
+	C.gtk_tree_model_row_inserted(v.native(), path.native(), iter.native())
+}
+
+// RowHasChildToggled is a wrapper around gtk_tree_model_row_has_child_toggled().
+func (v *TreeModel) RowHasChildToggled(path *TreePath, iter *TreeIter) {
+	C.gtk_tree_model_row_has_child_toggled(v.native(), path.native(), iter.native())
+}
+
+// RowDeleted is a wrapper around gtk_tree_model_row_deleted().
+func (v *TreeModel) RowDeleted(path *TreePath) {
+	C.gtk_tree_model_row_deleted(v.native(), path.native())
+}
+
+// RowsReordered is a wrapper around gtk_tree_model_rows_reordered().
+// newOrder[newPosition] is the former position of the row now at
+// newPosition, and must have as many elements as iter has children. A nil
+// iter and an empty path stand for the top level.
+func (v *TreeModel) RowsReordered(path *TreePath, iter *TreeIter, newOrder []int) {
+	if len(newOrder) == 0 {
+		return
+	}
+	corder := make([]C.gint, len(newOrder))
+	for i, o := range newOrder {
+		corder[i] = C.gint(o)
+	}
+	C.gtk_tree_model_rows_reordered(v.native(), path.native(), iter.native(), &corder[0])
+}
+
 /*
  * GtkTreeModelFilter
  */
@@ -9427,6 +9762,7 @@ type TreeModelFilter struct {
 
 	// Interfaces
 	TreeModel
+	TreeDragSource
 }
 
 func (v *TreeModelFilter) native() *C.GtkTreeModelFilter {
@@ -9452,7 +9788,7 @@ func marshalTreeModelFilter(p uintptr) (interface{}, error) {
 
 func wrapTreeModelFilter(obj *glib.Object) *TreeModelFilter {
 	tm := wrapTreeModel(obj)
-	return &TreeModelFilter{obj, *tm}
+	return &TreeModelFilter{obj, *tm, TreeDragSource{obj}}
 }
 
 // SetVisibleColumn is a wrapper around gtk_tree_model_filter_set_visible_column().
@@ -9503,6 +9839,61 @@ func (v *TreeModelFilter) Refilter() {
 	C.gtk_tree_model_filter_refilter(v.native())
 }
 
+// GetModel is a wrapper around gtk_tree_model_filter_get_model(). It returns
+// the child model being filtered.
+func (v *TreeModelFilter) GetModel() (ITreeModel, error) {
+	c := C.gtk_tree_model_filter_get_model(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return castTreeModel(c)
+}
+
+// ClearCache is a wrapper around gtk_tree_model_filter_clear_cache().
+func (v *TreeModelFilter) ClearCache() {
+	C.gtk_tree_model_filter_clear_cache(v.native())
+}
+
+// TreeModelFilterModifyFunc defines the function prototype for the modify
+// function (f arg) to TreeModelFilter.SetModifyFunc. It returns the value of
+// column for the row at iter, which is converted to the type of the column.
+// A nil value leaves the column unset.
+type TreeModelFilterModifyFunc func(model *TreeModelFilter, iter *TreeIter, column int) interface{}
+
+var (
+	treeModelFilterModifyFuncRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]TreeModelFilterModifyFunc
+	}{
+		next: 1,
+		m:    make(map[int]TreeModelFilterModifyFunc),
+	}
+)
+
+// SetModifyFunc is a wrapper around gtk_tree_model_filter_set_modify_func().
+// The filter then has the columns of the given types, whose values are all
+// provided by f, typically computed from the row of the child model found
+// with ConvertIterToChildIter. It may only be called once, before the
+// filter is used by a view.
+func (v *TreeModelFilter) SetModifyFunc(types []glib.Type, f TreeModelFilterModifyFunc) {
+	treeModelFilterModifyFuncRegistry.Lock()
+	id := treeModelFilterModifyFuncRegistry.next
+	treeModelFilterModifyFuncRegistry.next++
+	treeModelFilterModifyFuncRegistry.m[id] = f
+	treeModelFilterModifyFuncRegistry.Unlock()
+
+	gtypes := make([]C.GType, len(types))
+	for i, t := range types {
+		gtypes[i] = C.GType(t)
+	}
+	var ptypes *C.GType
+	if len(gtypes) > 0 {
+		ptypes = &gtypes[0]
+	}
+	C._gtk_tree_model_filter_set_modify_func(v.native(), C.gint(len(types)), ptypes, C.gpointer(uintptr(id)))
+}
+
 // TreeModelFilterVisibleFunc defines the function prototype for the filter visibility function (f arg)
 // to TreeModelFilter.SetVisibleFunc.
 type TreeModelFilterVisibleFunc func(model *TreeModelFilter, iter *TreeIter, userData ...interface{}) bool
@@ -9633,6 +10024,17 @@ func (v *TreePath) Up() bool {
 	return gobool(C.gtk_tree_path_up(v.native()))
 }
 
+// TreePathNew() is a wrapper around gtk_tree_path_new().
+func TreePathNew() (*TreePath, error) {
+	c := C.gtk_tree_path_new()
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	t := &TreePath{c}
+	runtime.SetFinalizer(t, (*TreePath).free)
+	return t, nil
+}
+
 // TreePathNewFirst() is a wrapper around gtk_tree_path_new_first().
 func TreePathNewFirst() (*TreePath, error) {
 	c := C.gtk_tree_path_new_first()
@@ -10020,6 +10422,7 @@ type TreeModelSort struct {
 	// Interfaces
 	TreeModel
 	TreeSortable
+	TreeDragSource
 }
 
 // native returns a pointer to the underlying GtkTreeModelSort
@@ -10040,7 +10443,7 @@ func marshalTreeModelSort(p uintptr) (interface{}, error) {
 func wrapTreeModelSort(obj *glib.Object) *TreeModelSort {
 	tm := wrapTreeModel(obj)
 	ts := wrapTreeSortable(obj)
-	return &TreeModelSort{obj, *tm, *ts}
+	return &TreeModelSort{obj, *tm, *ts, TreeDragSource{obj}}
 }
 
 func (v *TreeModelSort) toTreeModel() *C.GtkTreeModel {
@@ -10134,6 +10537,69 @@ func (v *TreeModelSort) IterIsValid(iter *TreeIter) bool {
 	return gobool(C.gtk_tree_model_sort_iter_is_valid(v.native(), iter.native()))
 }
 
+// TreeModelBaseIter converts iter of model, which may be a TreeModelFilter or
+// a TreeModelSort stacked over other filter and sort models, to an iter of the
+// model at the bottom of the stack, such as a ListStore. It returns that model,
+// wrapped as its own type such as *ListStore, along with the converted iter.
+// This maps the selection of a view showing a filtered or sorted model back
+// to the rows of the underlying store.
+func TreeModelBaseIter(model ITreeModel, iter *TreeIter) (ITreeModel, *TreeIter, error) {
+	for {
+		// The model may be wrapped as a plain *TreeModel, as returned by
+		// TreeSelection.GetSelected.
+		cast, err := castTreeModel(model.toTreeModel())
+		if err != nil {
+			return nil, nil, err
+		}
+		var child ITreeModel
+		switch m := cast.(type) {
+		case *TreeModelFilter:
+			child, err = m.GetModel()
+			iter = m.ConvertIterToChildIter(iter)
+		case *TreeModelSort:
+			child, err = m.GetModel()
+			iter = m.ConvertIterToChildIter(iter)
+		default:
+			return cast, iter, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		model = child
+	}
+}
+
+// TreeModelBasePath is the TreePath counterpart of TreeModelBaseIter. It
+// returns an error if a path has no counterpart in a child model.
+func TreeModelBasePath(model ITreeModel, path *TreePath) (ITreeModel, *TreePath, error) {
+	for {
+		// The model may be wrapped as a plain *TreeModel, as returned by
+		// TreeSelection.GetSelected.
+		cast, err := castTreeModel(model.toTreeModel())
+		if err != nil {
+			return nil, nil, err
+		}
+		var child ITreeModel
+		switch m := cast.(type) {
+		case *TreeModelFilter:
+			child, err = m.GetModel()
+			path = m.ConvertPathToChildPath(path)
+		case *TreeModelSort:
+			child, err = m.GetModel()
+			path = m.ConvertPathToChildPath(path)
+		default:
+			return cast, path, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if path == nil {
+			return nil, nil, errors.New("path has no counterpart in the child model")
+		}
+		model = child
+	}
+}
+
 /*
  * GtkTreeStore
  */
@@ -10145,6 +10611,8 @@ type TreeStore struct {
 	// Interfaces
 	TreeModel
 	TreeSortable
+	TreeDragSource
+	TreeDragDest
 }
 
 // native returns a pointer to the underlying GtkTreeStore.
@@ -10165,7 +10633,7 @@ func marshalTreeStore(p uintptr) (interface{}, error) {
 func wrapTreeStore(obj *glib.Object) *TreeStore {
 	tm := wrapTreeModel(obj)
 	ts := wrapTreeSortable(obj)
-	return &TreeStore{obj, *tm, *ts}
+	return &TreeStore{obj, *tm, *ts, TreeDragSource{obj}, TreeDragDest{obj}}
 }
 
 func (v *TreeStore) toTreeModel() *C.GtkTreeModel {
@@ -10451,6 +10919,10 @@ func VolumeButtonNew() (*VolumeButton, error) {
 
 type WrapFn interface{}
 
+// wrapMapLock guards WrapMap once the package is initialized, when
+// RegisterWidgetType may add to it while objects are cast.
+var wrapMapLock sync.RWMutex
+
 var WrapMap = map[string]WrapFn{
 	"GtkAccelGroup":           wrapAccelGroup,
 	"GtkAccelMao":             wrapAccelMap,
@@ -10551,7 +11023,9 @@ var WrapMap = map[string]WrapFn{
 // The className is the results of C.object_get_class_name(c) called on the native object.
 // The obj is the result of glib.Take(unsafe.Pointer(c)), used as a parameter for the wrapper functions.
 func castInternal(className string, obj *glib.Object) (interface{}, error) {
+	wrapMapLock.RLock()
 	fn, ok := WrapMap[className]
+	wrapMapLock.RUnlock()
 	if !ok {
 		return nil, errors.New("unrecognized class name '" + className + "'")
 	}
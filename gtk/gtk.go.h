@@ -460,6 +460,40 @@ toGtkCellRendererText(void *p)
 	return (GTK_CELL_RENDERER_TEXT(p));
 }
 
+static GtkCellRendererCombo *
+toGtkCellRendererCombo(void *p)
+{
+	return (GTK_CELL_RENDERER_COMBO(p));
+}
+
+static GtkCellRendererSpin *
+toGtkCellRendererSpin(void *p)
+{
+	return (GTK_CELL_RENDERER_SPIN(p));
+}
+
+static GtkCellRendererAccel *
+toGtkCellRendererAccel(void *p)
+{
+	return (GTK_CELL_RENDERER_ACCEL(p));
+}
+
+static GtkCellEditable *
+toGtkCellEditable(void *p)
+{
+	return (GTK_CELL_EDITABLE(p));
+}
+
+static inline void _gtk_cell_renderer_set_mode(GtkCellRenderer *cell, GtkCellRendererMode mode) {
+	g_object_set(cell, "mode", mode, NULL);
+}
+
+static inline GtkCellRendererMode _gtk_cell_renderer_get_mode(GtkCellRenderer *cell) {
+	GtkCellRendererMode mode;
+	g_object_get(cell, "mode", &mode, NULL);
+	return mode;
+}
+
 static GtkCellRendererToggle *
 toGtkCellRendererToggle(void *p)
 {
@@ -532,6 +566,18 @@ toGtkTreeSortable(void *p)
 	return (GTK_TREE_SORTABLE(p));
 }
 
+static GtkTreeDragSource *
+toGtkTreeDragSource(void *p)
+{
+	return (GTK_TREE_DRAG_SOURCE(p));
+}
+
+static GtkTreeDragDest *
+toGtkTreeDragDest(void *p)
+{
+	return (GTK_TREE_DRAG_DEST(p));
+}
+
 static GtkClipboard *
 toGtkClipboard(void *p)
 {
@@ -978,6 +1024,10 @@ static inline void _gtk_builder_connect_signals_full(GtkBuilder *builder) {
 	gtk_builder_connect_signals_full(builder, (GtkBuilderConnectFunc)(goBuilderConnect), NULL);
 }
 
+static inline GParamSpec *object_find_property(GObject *object, const gchar *property_name) {
+	return g_object_class_find_property(G_OBJECT_GET_CLASS(object), property_name);
+}
+
 extern void goPrintSettings (gchar *key,
 	                     gchar *value,
                          gpointer user_data);
@@ -1001,6 +1051,24 @@ static inline void _gtk_tree_model_filter_set_visible_func(GtkTreeModelFilter *f
     gtk_tree_model_filter_set_visible_func(filter, (GtkTreeModelFilterVisibleFunc)(goTreeModelFilterFuncs), user_data, NULL);
 }
 
+extern void goTreeModelFilterModifyFuncs (GtkTreeModel *model, GtkTreeIter *iter, GValue *value, gint column, gpointer data);
+extern void goTreeModelFilterModifyFuncsDestroy (gpointer data);
+
+static inline void _gtk_tree_model_filter_set_modify_func(GtkTreeModelFilter *filter, gint n_columns, GType *types, gpointer user_data) {
+	gtk_tree_model_filter_set_modify_func(filter, n_columns, types, (GtkTreeModelFilterModifyFunc)(goTreeModelFilterModifyFuncs), user_data, (GDestroyNotify)(goTreeModelFilterModifyFuncsDestroy));
+}
+
+extern void goCellDataFuncs (GObject *layout, GtkCellRenderer *cell, GtkTreeModel *model, GtkTreeIter *iter, gpointer data);
+extern void goCellDataFuncsDestroy (gpointer data);
+
+static inline void _gtk_tree_view_column_set_cell_data_func(GtkTreeViewColumn *column, GtkCellRenderer *cell, gpointer user_data) {
+	gtk_tree_view_column_set_cell_data_func(column, cell, (GtkTreeCellDataFunc)(goCellDataFuncs), user_data, (GDestroyNotify)(goCellDataFuncsDestroy));
+}
+
+static inline void _gtk_cell_layout_set_cell_data_func(GtkCellLayout *layout, GtkCellRenderer *cell, gpointer user_data) {
+	gtk_cell_layout_set_cell_data_func(layout, cell, (GtkCellLayoutDataFunc)(goCellDataFuncs), user_data, (GDestroyNotify)(goCellDataFuncsDestroy));
+}
+
 static inline void _gtk_text_buffer_insert_with_tag_by_name(GtkTextBuffer* buffer, GtkTextIter* iter, const gchar* text, gint len, const gchar* first_tag_name) {
 	gtk_text_buffer_insert_with_tags_by_name(buffer, iter, text, len, first_tag_name, NULL);
 }
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"unsafe"
 
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
 	"github.com/gotk3/gotk3/glib"
 )
 
@@ -46,7 +48,7 @@ func goBuilderConnect(builder *C.GtkBuilder,
 	user_data C.gpointer) {
 
 	builderSignals.Lock()
-	signals, ok := builderSignals.m[builder]
+	conn, ok := builderSignals.m[builder]
 	builderSignals.Unlock()
 
 	if !ok {
@@ -56,8 +58,14 @@ func goBuilderConnect(builder *C.GtkBuilder,
 	h := C.GoString((*C.char)(handler_name))
 	s := C.GoString((*C.char)(signal_name))
 
-	handler, ok := signals[h]
+	handler, ok := conn.handlers[h]
 	if !ok {
+		for _, name := range conn.missing {
+			if name == h {
+				return
+			}
+		}
+		conn.missing = append(conn.missing, h)
 		return
 	}
 
@@ -117,6 +125,55 @@ func goTreeModelFilterFuncs(filter *C.GtkTreeModelFilter, iter *C.GtkTreeIter, d
 		r.userData))
 }
 
+//export goTreeModelFilterModifyFuncs
+func goTreeModelFilterModifyFuncs(model *C.GtkTreeModel, iter *C.GtkTreeIter, value *C.GValue, column C.gint, data C.gpointer) {
+	id := int(uintptr(data))
+
+	treeModelFilterModifyFuncRegistry.RLock()
+	fn := treeModelFilterModifyFuncRegistry.m[id]
+	treeModelFilterModifyFuncRegistry.RUnlock()
+
+	goIter := &TreeIter{(C.GtkTreeIter)(*iter)}
+	v := fn(wrapTreeModelFilter(glib.Take(unsafe.Pointer(model))), goIter, int(column))
+	if v == nil {
+		return
+	}
+	gv, err := glib.GValue(v)
+	if err != nil {
+		return
+	}
+	C.g_value_transform((*C.GValue)(unsafe.Pointer(gv.Native())), value)
+}
+
+//export goTreeModelFilterModifyFuncsDestroy
+func goTreeModelFilterModifyFuncsDestroy(data C.gpointer) {
+	treeModelFilterModifyFuncRegistry.Lock()
+	delete(treeModelFilterModifyFuncRegistry.m, int(uintptr(data)))
+	treeModelFilterModifyFuncRegistry.Unlock()
+}
+
+//export goCellDataFuncs
+func goCellDataFuncs(layout *C.GObject, cell *C.GtkCellRenderer, model *C.GtkTreeModel, iter *C.GtkTreeIter, data C.gpointer) {
+	id := int(uintptr(data))
+
+	cellDataFuncRegistry.RLock()
+	fn := cellDataFuncRegistry.m[id]
+	cellDataFuncRegistry.RUnlock()
+
+	goIter := &TreeIter{(C.GtkTreeIter)(*iter)}
+	fn(glib.Take(unsafe.Pointer(layout)),
+		wrapCellRenderer(glib.Take(unsafe.Pointer(cell))),
+		wrapTreeModel(glib.Take(unsafe.Pointer(model))),
+		goIter)
+}
+
+//export goCellDataFuncsDestroy
+func goCellDataFuncsDestroy(data C.gpointer) {
+	cellDataFuncRegistry.Lock()
+	delete(cellDataFuncRegistry.m, int(uintptr(data)))
+	cellDataFuncRegistry.Unlock()
+}
+
 //export goTreeSortableSortFuncs
 func goTreeSortableSortFuncs(model *C.GtkTreeModel, a, b *C.GtkTreeIter, data C.gpointer) C.gint {
 	id := int(uintptr(data))
@@ -165,3 +222,444 @@ func goTreeSelectionForeachFunc(model *C.GtkTreeModel, path *C.GtkTreePath, iter
 		goIter,
 		r.userData)
 }
+
+//export goTreeModelGetFlags
+func goTreeModelGetFlags(id C.guint) C.GtkTreeModelFlags {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return 0
+	}
+	return C.GtkTreeModelFlags(impl.GetFlags())
+}
+
+//export goTreeModelGetNColumns
+func goTreeModelGetNColumns(id C.guint) C.gint {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return 0
+	}
+	return C.gint(impl.GetNColumns())
+}
+
+//export goTreeModelGetColumnType
+func goTreeModelGetColumnType(id C.guint, index C.gint) C.GType {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return C.GType(glib.TYPE_INVALID)
+	}
+	return C.GType(impl.GetColumnType(int(index)))
+}
+
+//export goTreeModelGetIter
+func goTreeModelGetIter(id C.guint, iter *C.GtkTreeIter, path *C.GtkTreePath) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	return gbool(impl.GetIter(wrapTreeIterPtr(iter), &TreePath{path}))
+}
+
+//export goTreeModelGetPath
+func goTreeModelGetPath(id C.guint, iter *C.GtkTreeIter) *C.GtkTreePath {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return nil
+	}
+	path := impl.GetPath(wrapTreeIterPtr(iter))
+	if path == nil {
+		return nil
+	}
+	// The caller owns the returned path, the Go one is freed by its finalizer.
+	return C.gtk_tree_path_copy(path.native())
+}
+
+//export goTreeModelGetValue
+func goTreeModelGetValue(id C.guint, iter *C.GtkTreeIter, column C.gint, value *C.GValue) {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return
+	}
+	columnType := impl.GetColumnType(int(column))
+	setTreeModelValue(value, columnType, impl.GetValue(wrapTreeIterPtr(iter), int(column)))
+}
+
+//export goTreeModelIterNext
+func goTreeModelIterNext(id C.guint, iter *C.GtkTreeIter) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	return gbool(impl.IterNext(wrapTreeIterPtr(iter)))
+}
+
+//export goTreeModelIterPrevious
+func goTreeModelIterPrevious(id C.guint, iter *C.GtkTreeIter) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	goIter := wrapTreeIterPtr(iter)
+	if p, ok := impl.(TreeModelIterPreviousImplementation); ok {
+		return gbool(p.IterPrevious(goIter))
+	}
+
+	// Same fallback as GtkTreeModel's default iter_previous.
+	path := impl.GetPath(goIter)
+	if path == nil || !path.Prev() {
+		return gbool(false)
+	}
+	return gbool(impl.GetIter(goIter, path))
+}
+
+//export goTreeModelIterChildren
+func goTreeModelIterChildren(id C.guint, iter, parent *C.GtkTreeIter) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	return gbool(impl.IterChildren(wrapTreeIterPtr(iter), wrapTreeIterPtr(parent)))
+}
+
+//export goTreeModelIterHasChild
+func goTreeModelIterHasChild(id C.guint, iter *C.GtkTreeIter) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	return gbool(impl.IterHasChild(wrapTreeIterPtr(iter)))
+}
+
+//export goTreeModelIterNChildren
+func goTreeModelIterNChildren(id C.guint, iter *C.GtkTreeIter) C.gint {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return 0
+	}
+	return C.gint(impl.IterNChildren(wrapTreeIterPtr(iter)))
+}
+
+//export goTreeModelIterNthChild
+func goTreeModelIterNthChild(id C.guint, iter, parent *C.GtkTreeIter, n C.gint) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	return gbool(impl.IterNthChild(wrapTreeIterPtr(iter), wrapTreeIterPtr(parent), int(n)))
+}
+
+//export goTreeModelIterParent
+func goTreeModelIterParent(id C.guint, iter, child *C.GtkTreeIter) C.gboolean {
+	impl := goTreeModelImplementation(id)
+	if impl == nil {
+		return gbool(false)
+	}
+	return gbool(impl.IterParent(wrapTreeIterPtr(iter), wrapTreeIterPtr(child)))
+}
+
+//export goTreeModelRefNode
+func goTreeModelRefNode(id C.guint, iter *C.GtkTreeIter) {
+	if r, ok := goTreeModelImplementation(id).(TreeModelRefNodeImplementation); ok {
+		r.RefNode(wrapTreeIterPtr(iter))
+	}
+}
+
+//export goTreeModelUnrefNode
+func goTreeModelUnrefNode(id C.guint, iter *C.GtkTreeIter) {
+	if r, ok := goTreeModelImplementation(id).(TreeModelRefNodeImplementation); ok {
+		r.UnrefNode(wrapTreeIterPtr(iter))
+	}
+}
+
+//export goTreeModelFinalize
+func goTreeModelFinalize(id C.guint) {
+	goTreeModelRegistry.Lock()
+	delete(goTreeModelRegistry.m, int(id))
+	goTreeModelRegistry.Unlock()
+}
+
+//export goClipboardTextReceived
+func goClipboardTextReceived(clipboard *C.GtkClipboard, text *C.gchar, data C.gpointer) {
+	f := takeClipboardRequest(data).(ClipboardTextReceivedFunc)
+	c := wrapClipboard(glib.Take(unsafe.Pointer(clipboard)))
+	if text == nil {
+		f(c, "", false)
+		return
+	}
+	f(c, goString(text), true)
+}
+
+//export goClipboardImageReceived
+func goClipboardImageReceived(clipboard *C.GtkClipboard, pixbuf *C.GdkPixbuf, data C.gpointer) {
+	f := takeClipboardRequest(data).(ClipboardImageReceivedFunc)
+	var p *gdk.Pixbuf
+	if pixbuf != nil {
+		p = &gdk.Pixbuf{glib.Take(unsafe.Pointer(pixbuf))}
+	}
+	f(wrapClipboard(glib.Take(unsafe.Pointer(clipboard))), p)
+}
+
+//export goClipboardURIsReceived
+func goClipboardURIsReceived(clipboard *C.GtkClipboard, uris **C.gchar, data C.gpointer) {
+	f := takeClipboardRequest(data).(ClipboardURIReceivedFunc)
+	var s []string
+	if uris != nil {
+		// The array is owned by GTK, so the strings are copied without
+		// freeing it.
+		for _, uri := range (*[1 << 20]*C.gchar)(unsafe.Pointer(uris)) {
+			if uri == nil {
+				break
+			}
+			s = append(s, goString(uri))
+		}
+	}
+	f(wrapClipboard(glib.Take(unsafe.Pointer(clipboard))), s)
+}
+
+//export goClipboardContentsReceived
+func goClipboardContentsReceived(clipboard *C.GtkClipboard, selectionData *C.GtkSelectionData, data C.gpointer) {
+	f := takeClipboardRequest(data).(ClipboardReceivedFunc)
+	f(wrapClipboard(glib.Take(unsafe.Pointer(clipboard))),
+		WrapSelectionData(uintptr(unsafe.Pointer(selectionData))))
+}
+
+//export goClipboardTargetsReceived
+func goClipboardTargetsReceived(clipboard *C.GtkClipboard, atoms *C.GdkAtom, nAtoms C.gint, data C.gpointer) {
+	f := takeClipboardRequest(data).(ClipboardTargetsReceivedFunc)
+	var targets []gdk.Atom
+	if atoms != nil {
+		s := (*[1 << 20]C.GdkAtom)(unsafe.Pointer(atoms))[:nAtoms:nAtoms]
+		targets = make([]gdk.Atom, len(s))
+		for i, a := range s {
+			targets[i] = gdk.Atom(uintptr(unsafe.Pointer(a)))
+		}
+	}
+	f(wrapClipboard(glib.Take(unsafe.Pointer(clipboard))), targets)
+}
+
+//export goClipboardGet
+func goClipboardGet(clipboard *C.GtkClipboard, selectionData *C.GtkSelectionData, info C.guint, data C.gpointer) {
+	id := int(uintptr(data))
+
+	clipboardProviderRegistry.RLock()
+	r := clipboardProviderRegistry.m[id]
+	clipboardProviderRegistry.RUnlock()
+
+	if r.get == nil {
+		return
+	}
+	r.get(wrapClipboard(glib.Take(unsafe.Pointer(clipboard))),
+		WrapSelectionData(uintptr(unsafe.Pointer(selectionData))), uint(info))
+}
+
+//export goClipboardClear
+func goClipboardClear(clipboard *C.GtkClipboard, data C.gpointer) {
+	id := int(uintptr(data))
+
+	clipboardProviderRegistry.Lock()
+	r := clipboardProviderRegistry.m[id]
+	// GTK is done with the provider once its data is cleared
+	delete(clipboardProviderRegistry.m, id)
+	clipboardProviderRegistry.Unlock()
+
+	if r.clear != nil {
+		r.clear(wrapClipboard(glib.Take(unsafe.Pointer(clipboard))))
+	}
+}
+
+//export goCellRendererGetPreferredWidth
+func goCellRendererGetPreferredWidth(id C.guint, widget *C.GtkWidget, minimumSize, naturalSize *C.gint) {
+	min, nat := goCellRendererImplementation(id).GetPreferredWidth(wrapWidget(glib.Take(unsafe.Pointer(widget))))
+	if minimumSize != nil {
+		*minimumSize = C.gint(min)
+	}
+	if naturalSize != nil {
+		*naturalSize = C.gint(nat)
+	}
+}
+
+//export goCellRendererGetPreferredHeight
+func goCellRendererGetPreferredHeight(id C.guint, widget *C.GtkWidget, minimumSize, naturalSize *C.gint) {
+	min, nat := goCellRendererImplementation(id).GetPreferredHeight(wrapWidget(glib.Take(unsafe.Pointer(widget))))
+	if minimumSize != nil {
+		*minimumSize = C.gint(min)
+	}
+	if naturalSize != nil {
+		*naturalSize = C.gint(nat)
+	}
+}
+
+//export goCellRendererRender
+func goCellRendererRender(id C.guint, cr *C.cairo_t, widget *C.GtkWidget,
+	backgroundArea, cellArea *C.GdkRectangle, flags C.GtkCellRendererState) {
+
+	goCellRendererImplementation(id).Render(
+		cairo.WrapContext(uintptr(unsafe.Pointer(cr))),
+		wrapWidget(glib.Take(unsafe.Pointer(widget))),
+		gdk.WrapRectangle(uintptr(unsafe.Pointer(backgroundArea))),
+		gdk.WrapRectangle(uintptr(unsafe.Pointer(cellArea))),
+		CellRendererState(flags))
+}
+
+//export goCellRendererActivate
+func goCellRendererActivate(id C.guint, event *C.GdkEvent, widget *C.GtkWidget, path *C.gchar,
+	backgroundArea, cellArea *C.GdkRectangle, flags C.GtkCellRendererState) C.gboolean {
+
+	impl, ok := goCellRendererImplementation(id).(CellRendererActivateImplementation)
+	if !ok {
+		return gbool(false)
+	}
+	return gbool(impl.Activate(
+		gdk.WrapEvent(uintptr(unsafe.Pointer(event))),
+		wrapWidget(glib.Take(unsafe.Pointer(widget))),
+		goString(path),
+		gdk.WrapRectangle(uintptr(unsafe.Pointer(backgroundArea))),
+		gdk.WrapRectangle(uintptr(unsafe.Pointer(cellArea))),
+		CellRendererState(flags)))
+}
+
+//export goCellRendererStartEditing
+func goCellRendererStartEditing(id C.guint, event *C.GdkEvent, widget *C.GtkWidget, path *C.gchar,
+	backgroundArea, cellArea *C.GdkRectangle, flags C.GtkCellRendererState) *C.GtkCellEditable {
+
+	impl, ok := goCellRendererImplementation(id).(CellRendererStartEditingImplementation)
+	if !ok {
+		return nil
+	}
+	editable := impl.StartEditing(
+		gdk.WrapEvent(uintptr(unsafe.Pointer(event))),
+		wrapWidget(glib.Take(unsafe.Pointer(widget))),
+		goString(path),
+		gdk.WrapRectangle(uintptr(unsafe.Pointer(backgroundArea))),
+		gdk.WrapRectangle(uintptr(unsafe.Pointer(cellArea))),
+		CellRendererState(flags))
+	if editable == nil {
+		return nil
+	}
+	return editable.toCellEditable()
+}
+
+//export goCellRendererFinalize
+func goCellRendererFinalize(id C.guint) {
+	goCellRendererRegistry.Lock()
+	delete(goCellRendererRegistry.m, int(id))
+	goCellRendererRegistry.Unlock()
+}
+
+//export goWidgetGetPreferredWidth
+func goWidgetGetPreferredWidth(id C.guint, widget *C.GtkWidget, minimumSize, naturalSize *C.gint) {
+	min, nat := goWidgetImplementation(id).GetPreferredWidth(wrapWidget(glib.Take(unsafe.Pointer(widget))))
+	if minimumSize != nil {
+		*minimumSize = C.gint(min)
+	}
+	if naturalSize != nil {
+		*naturalSize = C.gint(nat)
+	}
+}
+
+//export goWidgetGetPreferredHeight
+func goWidgetGetPreferredHeight(id C.guint, widget *C.GtkWidget, minimumSize, naturalSize *C.gint) {
+	min, nat := goWidgetImplementation(id).GetPreferredHeight(wrapWidget(glib.Take(unsafe.Pointer(widget))))
+	if minimumSize != nil {
+		*minimumSize = C.gint(min)
+	}
+	if naturalSize != nil {
+		*naturalSize = C.gint(nat)
+	}
+}
+
+//export goWidgetGetRequestMode
+func goWidgetGetRequestMode(id C.guint, widget *C.GtkWidget, mode *C.GtkSizeRequestMode) C.gboolean {
+	impl, ok := goWidgetImplementation(id).(WidgetHeightForWidthImplementation)
+	if !ok {
+		return gbool(false)
+	}
+	*mode = C.GtkSizeRequestMode(impl.GetRequestMode(wrapWidget(glib.Take(unsafe.Pointer(widget)))))
+	return gbool(true)
+}
+
+//export goWidgetGetPreferredHeightForWidth
+func goWidgetGetPreferredHeightForWidth(id C.guint, widget *C.GtkWidget, width C.gint,
+	minimumSize, naturalSize *C.gint) C.gboolean {
+
+	impl, ok := goWidgetImplementation(id).(WidgetHeightForWidthImplementation)
+	if !ok {
+		return gbool(false)
+	}
+	min, nat := impl.GetPreferredHeightForWidth(wrapWidget(glib.Take(unsafe.Pointer(widget))), int(width))
+	if minimumSize != nil {
+		*minimumSize = C.gint(min)
+	}
+	if naturalSize != nil {
+		*naturalSize = C.gint(nat)
+	}
+	return gbool(true)
+}
+
+//export goWidgetGetPreferredWidthForHeight
+func goWidgetGetPreferredWidthForHeight(id C.guint, widget *C.GtkWidget, height C.gint,
+	minimumSize, naturalSize *C.gint) C.gboolean {
+
+	impl, ok := goWidgetImplementation(id).(WidgetHeightForWidthImplementation)
+	if !ok {
+		return gbool(false)
+	}
+	min, nat := impl.GetPreferredWidthForHeight(wrapWidget(glib.Take(unsafe.Pointer(widget))), int(height))
+	if minimumSize != nil {
+		*minimumSize = C.gint(min)
+	}
+	if naturalSize != nil {
+		*naturalSize = C.gint(nat)
+	}
+	return gbool(true)
+}
+
+//export goWidgetSizeAllocate
+func goWidgetSizeAllocate(id C.guint, widget *C.GtkWidget, allocation *C.GtkAllocation) {
+	impl, ok := goWidgetImplementation(id).(WidgetSizeAllocateImplementation)
+	if !ok {
+		return
+	}
+	rect := gdk.WrapRectangle(uintptr(unsafe.Pointer(allocation)))
+	impl.SizeAllocate(wrapWidget(glib.Take(unsafe.Pointer(widget))), &Allocation{*rect})
+}
+
+//export goWidgetDraw
+func goWidgetDraw(id C.guint, widget *C.GtkWidget, cr *C.cairo_t) C.gboolean {
+	impl, ok := goWidgetImplementation(id).(WidgetDrawImplementation)
+	if !ok {
+		return gbool(false)
+	}
+	return gbool(impl.Draw(wrapWidget(glib.Take(unsafe.Pointer(widget))),
+		cairo.WrapContext(uintptr(unsafe.Pointer(cr)))))
+}
+
+//export goContainerAdd
+func goContainerAdd(id C.guint, container *C.GtkContainer, child *C.GtkWidget) {
+	goContainerImplementation(id).Add(
+		wrapContainer(glib.Take(unsafe.Pointer(container))),
+		wrapWidget(glib.Take(unsafe.Pointer(child))))
+}
+
+//export goContainerRemove
+func goContainerRemove(id C.guint, container *C.GtkContainer, child *C.GtkWidget) {
+	goContainerImplementation(id).Remove(
+		wrapContainer(glib.Take(unsafe.Pointer(container))),
+		wrapWidget(glib.Take(unsafe.Pointer(child))))
+}
+
+//export goContainerForall
+func goContainerForall(id C.guint, container *C.GtkContainer, includeInternals C.gboolean,
+	callback C.GtkCallback, callbackData C.gpointer) {
+
+	impl := goContainerImplementation(id)
+	if impl == nil {
+		return
+	}
+	impl.Forall(wrapContainer(glib.Take(unsafe.Pointer(container))), gobool(includeInternals),
+		containerCallback(callback, callbackData))
+}
+
+//export goWidgetFinalize
+func goWidgetFinalize(id C.guint) {
+	unregisterGoWidget(int(id))
+}
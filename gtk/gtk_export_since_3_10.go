@@ -42,3 +42,59 @@ func goListBoxSortFuncs(row1 *C.GtkListBoxRow, row2 *C.GtkListBoxRow, userData C
 
 	return C.gint(r.fn(wrapListBoxRow(glib.Take(unsafe.Pointer(row1))), wrapListBoxRow(glib.Take(unsafe.Pointer(row2))), r.userData))
 }
+
+//export goWidgetClassInit
+func goWidgetClassInit(klass *C.GtkWidgetClass, id C.guint) {
+	widgetClassRegistry.RLock()
+	data := widgetClassRegistry.m[int(id)]
+	widgetClassRegistry.RUnlock()
+
+	if data.init != nil {
+		data.init(&WidgetClass{klass, int(id), data})
+	}
+}
+
+//export goWidgetTemplateConnect
+func goWidgetTemplateConnect(builder *C.GtkBuilder,
+	object *C.GObject,
+	signal_name *C.gchar,
+	handler_name *C.gchar,
+	connect_object *C.GObject,
+	flags C.GConnectFlags,
+	user_data C.gpointer) {
+
+	widgetClassRegistry.RLock()
+	data := widgetClassRegistry.m[int(uintptr(user_data))]
+	h := C.GoString((*C.char)(handler_name))
+	handler, ok := data.callbacks[h]
+	widgetClassRegistry.RUnlock()
+
+	if !ok {
+		// Called from the main thread, as are Widget.InitTemplate and
+		// WidgetNewWithType, which hold the lock while collecting.
+		if templateInit.collecting {
+			templateInit.missing = append(templateInit.missing, h)
+		}
+		return
+	}
+
+	gobj := glib.Object{glib.ToGObject(unsafe.Pointer(object))}
+	s := C.GoString((*C.char)(signal_name))
+	var userData []interface{}
+	if connect_object != nil {
+		data, err := cast(connect_object)
+		if err != nil {
+			data = glib.Take(unsafe.Pointer(connect_object))
+		}
+		if flags&C.G_CONNECT_SWAPPED != 0 {
+			handler = swappedHandler(handler, data, signalNParams(object, signal_name))
+		} else {
+			userData = append(userData, data)
+		}
+	}
+	if flags&C.G_CONNECT_AFTER != 0 {
+		gobj.ConnectAfter(s, handler, userData...)
+	} else {
+		gobj.Connect(s, handler, userData...)
+	}
+}
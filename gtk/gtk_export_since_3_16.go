@@ -5,14 +5,27 @@ package gtk
 
 // #include <gtk/gtk.h>
 import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
 
 //export goListBoxCreateWidgetFuncs
-func goListBoxCreateWidgetFuncs(item, userData C.gpointer) {
+func goListBoxCreateWidgetFuncs(item, userData C.gpointer) *C.GtkWidget {
 	id := int(uintptr(userData))
 
 	listBoxCreateWidgetFuncRegistry.RLock()
 	r := listBoxCreateWidgetFuncRegistry.m[id]
 	listBoxCreateWidgetFuncRegistry.RUnlock()
 
-	r.fn(uintptr(item), r.userData)
+	widget := r.fn(glib.Take(unsafe.Pointer(item)), r.userData...)
+	return createdWidget(widget)
+}
+
+//export goListBoxCreateWidgetFuncsDestroy
+func goListBoxCreateWidgetFuncsDestroy(userData C.gpointer) {
+	listBoxCreateWidgetFuncRegistry.Lock()
+	delete(listBoxCreateWidgetFuncRegistry.m, int(uintptr(userData)))
+	listBoxCreateWidgetFuncRegistry.Unlock()
 }
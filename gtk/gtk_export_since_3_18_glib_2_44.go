@@ -0,0 +1,31 @@
+// Same copyright and license as the rest of the files in this project
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!glib_2_40,!glib_2_42
+
+package gtk
+
+// #include <gtk/gtk.h>
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+//export goFlowBoxCreateWidgetFuncs
+func goFlowBoxCreateWidgetFuncs(item, userData C.gpointer) *C.GtkWidget {
+	id := int(uintptr(userData))
+
+	flowBoxCreateWidgetFuncRegistry.RLock()
+	r := flowBoxCreateWidgetFuncRegistry.m[id]
+	flowBoxCreateWidgetFuncRegistry.RUnlock()
+
+	widget := r.fn(glib.Take(unsafe.Pointer(item)), r.userData...)
+	return createdWidget(widget)
+}
+
+//export goFlowBoxCreateWidgetFuncsDestroy
+func goFlowBoxCreateWidgetFuncsDestroy(userData C.gpointer) {
+	flowBoxCreateWidgetFuncRegistry.Lock()
+	delete(flowBoxCreateWidgetFuncRegistry.m, int(uintptr(userData)))
+	flowBoxCreateWidgetFuncRegistry.Unlock()
+}
@@ -57,6 +57,11 @@ const (
 	ALIGN_BASELINE Align = C.GTK_ALIGN_BASELINE
 )
 
+const (
+	BUILDER_ERROR_OBJECT_TYPE_REFUSED BuilderError = C.GTK_BUILDER_ERROR_OBJECT_TYPE_REFUSED
+	BUILDER_ERROR_TEMPLATE_MISMATCH   BuilderError = C.GTK_BUILDER_ERROR_TEMPLATE_MISMATCH
+)
+
 // RevealerTransitionType is a representation of GTK's GtkRevealerTransitionType.
 type RevealerTransitionType int
 
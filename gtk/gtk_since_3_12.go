@@ -46,6 +46,11 @@ const (
 	BUTTONBOX_EXPAND ButtonBoxStyle = C.GTK_BUTTONBOX_EXPAND
 )
 
+const (
+	BUILDER_ERROR_INVALID_PROPERTY BuilderError = C.GTK_BUILDER_ERROR_INVALID_PROPERTY
+	BUILDER_ERROR_INVALID_SIGNAL   BuilderError = C.GTK_BUILDER_ERROR_INVALID_SIGNAL
+)
+
 func init() {
 	tm := []glib.TypeMarshaler{
 		// Objects/Interfaces
@@ -354,7 +359,6 @@ func (fb *FlowBox) GetSelectionMode() SelectionMode {
 // TODO gtk_flow_box_invalidate_filter()
 // TODO gtk_flow_box_set_sort_func()
 // TODO gtk_flow_box_invalidate_sort()
-// TODO 3.18 gtk_flow_box_bind_model()
 
 /*
  * FlowBoxChild
@@ -22,6 +22,10 @@ const (
 	STATE_FLAG_CHECKED StateFlags = C.GTK_STATE_FLAG_CHECKED
 )
 
+const (
+	BUILDER_ERROR_INVALID_ID BuilderError = C.GTK_BUILDER_ERROR_INVALID_ID
+)
+
 /*
  * GtkStack
  */
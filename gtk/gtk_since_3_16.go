@@ -80,7 +80,9 @@ func marshalButtonRole(p uintptr) (interface{}, error) {
  */
 
 // ListBoxCreateWidgetFunc is a representation of GtkListBoxCreateWidgetFunc.
-type ListBoxCreateWidgetFunc func(item interface{}, userData ...interface{}) int
+// item is the model item for the row; use glib.GoListItemValue to retrieve
+// the Go value of items served by a glib.GoListModel.
+type ListBoxCreateWidgetFunc func(item *glib.Object, userData ...interface{}) IWidget
 
 type listBoxCreateWidgetFuncData struct {
 	fn       ListBoxCreateWidgetFunc
@@ -98,6 +100,19 @@ var (
 	}
 )
 
+// createdWidget returns the native widget of w with a full reference, as
+// expected from the create-widget callbacks of model bindings.
+func createdWidget(w IWidget) *C.GtkWidget {
+	if w == nil {
+		return nil
+	}
+	c := w.toWidget()
+	if c != nil {
+		C.g_object_ref(C.gpointer(c))
+	}
+	return c
+}
+
 /*
  * GtkScrolledWindow
  */
@@ -50,9 +50,14 @@ toGtkGLArea(void *p)
   return (GTK_GL_AREA(p));
 }
 
-extern void goListBoxCreateWidgetFuncs (gpointer item,
-                                		gpointer user_data);
+extern GtkWidget *goListBoxCreateWidgetFuncs (gpointer item,
+                                              gpointer user_data);
+extern void goListBoxCreateWidgetFuncsDestroy (gpointer user_data);
 
 static inline void _gtk_list_box_bind_model(GtkListBox *box, GListModel *model, gpointer user_data) {
-	gtk_list_box_bind_model(box, model, (GtkListBoxCreateWidgetFunc)(goListBoxCreateWidgetFuncs), user_data, NULL);
+	gtk_list_box_bind_model(box, model, (GtkListBoxCreateWidgetFunc)(goListBoxCreateWidgetFuncs), user_data, (GDestroyNotify)(goListBoxCreateWidgetFuncsDestroy));
+}
+
+static inline void _gtk_list_box_unbind_model(GtkListBox *box) {
+	gtk_list_box_bind_model(box, NULL, NULL, NULL, NULL);
 }
@@ -14,9 +14,17 @@ import (
 	"github.com/gotk3/gotk3/glib"
 )
 
-// BindModel is a wrapper around gtk_list_box_bind_model().
+// BindModel is a wrapper around gtk_list_box_bind_model(). The box is kept
+// in sync with listModel; createWidgetFunc is called for every item and must
+// return a new widget representing it. A glib.GoListModel can be bound
+// through its embedded ListModel. Passing a nil listModel removes a previous
+// binding.
 func (v *ListBox) BindModel(listModel *glib.ListModel, createWidgetFunc ListBoxCreateWidgetFunc, userData ...interface{}) {
-	// TODO: figure out a way to determine when we can clean up
+	if listModel == nil {
+		C._gtk_list_box_unbind_model(v.native())
+		return
+	}
+
 	listBoxCreateWidgetFuncRegistry.Lock()
 	id := listBoxCreateWidgetFuncRegistry.next
 	listBoxCreateWidgetFuncRegistry.next++
@@ -0,0 +1,15 @@
+// Same copyright and license as the rest of the files in this project
+
+#pragma once
+
+extern GtkWidget *goFlowBoxCreateWidgetFuncs (gpointer item,
+                                              gpointer user_data);
+extern void goFlowBoxCreateWidgetFuncsDestroy (gpointer user_data);
+
+static inline void _gtk_flow_box_bind_model(GtkFlowBox *box, GListModel *model, gpointer user_data) {
+	gtk_flow_box_bind_model(box, model, (GtkFlowBoxCreateWidgetFunc)(goFlowBoxCreateWidgetFuncs), user_data, (GDestroyNotify)(goFlowBoxCreateWidgetFuncsDestroy));
+}
+
+static inline void _gtk_flow_box_unbind_model(GtkFlowBox *box) {
+	gtk_flow_box_bind_model(box, NULL, NULL, NULL, NULL);
+}
@@ -0,0 +1,61 @@
+// Same copyright and license as the rest of the files in this project
+// The code in this file is only for GTK+ version 3.18+, as well as Glib version 2.44+
+
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!glib_2_40,!glib_2_42
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk_since_3_16.go.h"
+// #include "gtk_since_3_18.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+/*
+ * GtkFlowBox
+ */
+
+// FlowBoxCreateWidgetFunc is a representation of GtkFlowBoxCreateWidgetFunc.
+// item is the model item for the child; use glib.GoListItemValue to
+// retrieve the Go value of items served by a glib.GoListModel.
+type FlowBoxCreateWidgetFunc func(item *glib.Object, userData ...interface{}) IWidget
+
+type flowBoxCreateWidgetFuncData struct {
+	fn       FlowBoxCreateWidgetFunc
+	userData []interface{}
+}
+
+var (
+	flowBoxCreateWidgetFuncRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]flowBoxCreateWidgetFuncData
+	}{
+		next: 1,
+		m:    make(map[int]flowBoxCreateWidgetFuncData),
+	}
+)
+
+// BindModel is a wrapper around gtk_flow_box_bind_model(). The box is kept
+// in sync with listModel; createWidgetFunc is called for every item and must
+// return a new widget representing it. Passing a nil listModel removes a
+// previous binding.
+func (fb *FlowBox) BindModel(listModel *glib.ListModel, createWidgetFunc FlowBoxCreateWidgetFunc, userData ...interface{}) {
+	if listModel == nil {
+		C._gtk_flow_box_unbind_model(fb.native())
+		return
+	}
+
+	flowBoxCreateWidgetFuncRegistry.Lock()
+	id := flowBoxCreateWidgetFuncRegistry.next
+	flowBoxCreateWidgetFuncRegistry.next++
+	flowBoxCreateWidgetFuncRegistry.m[id] = flowBoxCreateWidgetFuncData{fn: createWidgetFunc, userData: userData}
+	flowBoxCreateWidgetFuncRegistry.Unlock()
+
+	C._gtk_flow_box_bind_model(fb.native(), C.toGListModel(unsafe.Pointer(listModel.Native())), C.gpointer(uintptr(id)))
+}
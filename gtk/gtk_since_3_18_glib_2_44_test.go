@@ -0,0 +1,67 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!glib_2_40,!glib_2_42
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestFlowBoxBindGoListModel(t *testing.T) {
+	names := []string{"one", "two", "three"}
+	model, err := glib.GoListModelNewFromSlice(&names)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	box, err := gtk.FlowBoxNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	box.BindModel(&model.ListModel, func(item *glib.Object, userData ...interface{}) gtk.IWidget {
+		v, ok := glib.GoListItemValue(item)
+		if !ok {
+			t.Error("item is not a GotkListItem")
+			return nil
+		}
+		label, err := gtk.LabelNew(v.(string) + userData[0].(string))
+		if err != nil {
+			t.Error(err)
+			return nil
+		}
+		return label
+	}, "!")
+
+	for i, name := range names {
+		fbc := box.GetChildAtIndex(i)
+		if fbc == nil {
+			t.Fatalf("missing child %d", i)
+		}
+		child, err := fbc.GetChild()
+		if err != nil {
+			t.Fatal(err)
+		}
+		label, ok := child.(*gtk.Label)
+		if !ok {
+			t.Fatalf("expected a *gtk.Label, got %T", child)
+		}
+		if text, _ := label.GetText(); text != name+"!" {
+			t.Errorf("expected child text %q, got %q", name+"!", text)
+		}
+	}
+
+	names = append(names, "four")
+	model.ItemsChanged(3, 0, 1)
+	if box.GetChildAtIndex(3) == nil {
+		t.Error("expected a child for the appended item")
+	}
+
+	box.BindModel(nil, nil)
+	if box.GetChildAtIndex(0) != nil {
+		t.Error("expected children to be removed after unbinding")
+	}
+}
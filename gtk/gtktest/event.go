@@ -0,0 +1,82 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtktest
+
+import (
+	"errors"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// ErrNotSimulated is returned when GDK could not simulate an event, as
+// happens with the backends lacking support for it.
+var ErrNotSimulated = errors.New("gtktest: the event could not be simulated")
+
+// toplevelPoint returns the GdkWindow of the toplevel of w, and the point
+// (x, y) of w in the coordinates of that window.
+func toplevelPoint(w gtk.IWidget, x, y int) (*gdk.Window, int, int, error) {
+	widget := w.ToWidget()
+	if !widget.GetMapped() {
+		return nil, 0, 0, errors.New("gtktest: " + Describe(w) + " is not mapped")
+	}
+	toplevel, err := widget.GetToplevel()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	tx, ty, err := widget.TranslateCoordinates(toplevel, x, y)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	window, err := toplevel.ToWidget().GetWindow()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return window, tx, ty, nil
+}
+
+// Click clicks the middle of w with the primary button.
+func Click(w gtk.IWidget) error {
+	widget := w.ToWidget()
+	return ClickAt(w, widget.GetAllocatedWidth()/2, widget.GetAllocatedHeight()/2, gdk.BUTTON_PRIMARY)
+}
+
+// ClickAt presses and releases button at the point (x, y) of the
+// allocation of w, with gdk.TestSimulateButton.
+func ClickAt(w gtk.IWidget, x, y int, button gdk.Button) error {
+	window, wx, wy, err := toplevelPoint(w, x, y)
+	if err != nil {
+		return err
+	}
+	if !gdk.TestSimulateButton(window, wx, wy, button, 0, gdk.EVENT_BUTTON_PRESS) ||
+		!gdk.TestSimulateButton(window, wx, wy, button, 0, gdk.EVENT_BUTTON_RELEASE) {
+		return ErrNotSimulated
+	}
+	return nil
+}
+
+// Key gives the focus to w, then presses and releases the key keyval with
+// modifiers, with gdk.TestSimulateKey.
+func Key(w gtk.IWidget, keyval uint, modifiers gdk.ModifierType) error {
+	window, _, _, err := toplevelPoint(w, 0, 0)
+	if err != nil {
+		return err
+	}
+	w.ToWidget().GrabFocus()
+	if !gdk.TestSimulateKey(window, -1, -1, keyval, modifiers, gdk.EVENT_KEY_PRESS) ||
+		!gdk.TestSimulateKey(window, -1, -1, keyval, modifiers, gdk.EVENT_KEY_RELEASE) {
+		return ErrNotSimulated
+	}
+	return nil
+}
+
+// Type gives the focus to w, then presses and releases the key of each
+// character of text in turn.
+func Type(w gtk.IWidget, text string) error {
+	for _, r := range text {
+		if err := Key(w, gdk.UnicodeToKeyval(r), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
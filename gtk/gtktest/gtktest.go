@@ -0,0 +1,275 @@
+// Same copyright and license as the rest of the files in this project
+
+// Package gtktest finds and drives the widgets of a GTK user interface from
+// Go tests.
+//
+// Widgets are looked up in the hierarchy under a root widget by name, CSS
+// class, type, accessible role or label. They are then clicked and typed
+// into with events simulated by GDK, and the tests wait for those events to
+// be handled before checking the state of the interface:
+//
+//	button, err := gtktest.Find(window, gtktest.ByRole("push button"), gtktest.ByLabel("OK"))
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	if err := gtktest.Click(button); err != nil {
+//		t.Fatal(err)
+//	}
+//	if err := gtktest.WaitIdle(time.Second); err != nil {
+//		t.Fatal(err)
+//	}
+//
+// The widgets must be shown in a toplevel window on a display, such as the
+// one of xvfb-run or of broadwayd with GDK_BACKEND=broadway in a CI job.
+// All functions must be called from the thread running GTK.
+package gtktest
+
+// #cgo pkg-config: gtk+-3.0
+// #include <gtk/gtk.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// A Matcher tells whether a widget is one being looked for.
+type Matcher func(w *gtk.Widget) bool
+
+// ByName matches the widgets named name with Widget.SetName.
+func ByName(name string) Matcher {
+	return func(w *gtk.Widget) bool {
+		n, err := w.GetName()
+		return err == nil && n == name
+	}
+}
+
+// ByClass matches the widgets whose style context has the CSS class.
+func ByClass(class string) Matcher {
+	return func(w *gtk.Widget) bool {
+		ctx, err := w.GetStyleContext()
+		return err == nil && ctx.HasClass(class)
+	}
+}
+
+// ByType matches the widgets of type typ or of a type derived from it.
+func ByType(typ glib.Type) Matcher {
+	return func(w *gtk.Widget) bool {
+		return w.IsA(typ)
+	}
+}
+
+// ByTypeName is like ByType, the type being given by its name, such as
+// "GtkButton".
+func ByTypeName(name string) Matcher {
+	return func(w *gtk.Widget) bool {
+		typ := glib.TypeFromName(name)
+		return typ != glib.TYPE_INVALID && w.IsA(typ)
+	}
+}
+
+// ByRole matches the widgets whose accessible has the role named role, such
+// as "push button" or "text". See AccessibleRole.
+func ByRole(role string) Matcher {
+	return func(w *gtk.Widget) bool {
+		return AccessibleRole(w) == role
+	}
+}
+
+// ByLabel matches the widgets whose label matches pattern, in which "*"
+// matches any sequence of characters and "?" matches any single character.
+// See Label.
+func ByLabel(pattern string) Matcher {
+	return func(w *gtk.Widget) bool {
+		return matchPattern(pattern, Label(w))
+	}
+}
+
+// Mapped matches the widgets which are shown on screen, and may thus
+// receive events.
+func Mapped() Matcher {
+	return func(w *gtk.Widget) bool {
+		return w.GetMapped()
+	}
+}
+
+// Walk calls fn for root and each of its descendants in depth-first order,
+// with their depth below root. The children of a widget are skipped if fn
+// returns false for it.
+func Walk(root gtk.IWidget, fn func(w *gtk.Widget, depth int) bool) {
+	walk(root.ToWidget(), 0, fn)
+}
+
+func walk(w *gtk.Widget, depth int, fn func(w *gtk.Widget, depth int) bool) {
+	if !fn(w, depth) {
+		return
+	}
+	for _, child := range children(w) {
+		walk(child, depth+1, fn)
+	}
+}
+
+// children returns the children of w if it is a container.
+func children(w *gtk.Widget) []*gtk.Widget {
+	if !w.IsA(glib.TypeFromName("GtkContainer")) {
+		return nil
+	}
+	container := &gtk.Container{Widget: *w}
+	list := container.GetChildren()
+	if list == nil {
+		return nil
+	}
+	defer list.Free()
+
+	var widgets []*gtk.Widget
+	list.Foreach(func(item interface{}) {
+		widgets = append(widgets, item.(*gtk.Widget))
+	})
+	return widgets
+}
+
+// FindAll returns root and its descendants matched by all of the matchers,
+// in depth-first order.
+func FindAll(root gtk.IWidget, matchers ...Matcher) []*gtk.Widget {
+	var found []*gtk.Widget
+	Walk(root, func(w *gtk.Widget, depth int) bool {
+		if matchAll(w, matchers) {
+			found = append(found, w)
+		}
+		return true
+	})
+	return found
+}
+
+// Find returns the first of root and its descendants matched by all of the
+// matchers, in depth-first order. The widget may be converted to its actual
+// type with Widget.Cast.
+func Find(root gtk.IWidget, matchers ...Matcher) (*gtk.Widget, error) {
+	var found *gtk.Widget
+	Walk(root, func(w *gtk.Widget, depth int) bool {
+		if found == nil && matchAll(w, matchers) {
+			found = w
+		}
+		return found == nil
+	})
+	if found == nil {
+		return nil, errors.New("gtktest: no widget matched under " + Describe(root))
+	}
+	return found, nil
+}
+
+func matchAll(w *gtk.Widget, matchers []Matcher) bool {
+	for _, m := range matchers {
+		if !m(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPattern reports whether s matches pattern, in which "*" matches any
+// sequence of characters and "?" any single character, as does
+// g_pattern_match_simple().
+func matchPattern(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	// The positions to go back to when a character does not match after a
+	// "*", which then matches one more character.
+	star, next := -1, 0
+	i, j := 0, 0
+	for j < len(r) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == r[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, next = i, j
+			i++
+		case star >= 0:
+			next++
+			i, j = star+1, next
+		default:
+			return false
+		}
+	}
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+	return i == len(p)
+}
+
+// AccessibleRole returns the name of the role of the accessible of w, such
+// as "push button", as returned by atk_role_get_name().
+func AccessibleRole(w gtk.IWidget) string {
+	accessible := C.gtk_widget_get_accessible((*C.GtkWidget)(unsafe.Pointer(w.ToWidget().Native())))
+	if accessible == nil {
+		return ""
+	}
+	return C.GoString((*C.char)(C.atk_role_get_name(C.atk_object_get_role(accessible))))
+}
+
+// Label returns the text of w if it is a GtkLabel, or else the string of
+// its "label" property, as found on buttons, frames or menu items. An empty
+// string is returned for the other widgets.
+func Label(w gtk.IWidget) string {
+	widget := w.ToWidget()
+	if widget.IsA(glib.TypeFromName("GtkLabel")) {
+		text, _ := (&gtk.Label{Widget: *widget}).GetText()
+		return text
+	}
+	if t, err := widget.GetPropertyType("label"); err != nil || t != glib.TYPE_STRING {
+		return ""
+	}
+	v, _ := widget.GetProperty("label")
+	s, _ := v.(string)
+	return s
+}
+
+// Describe returns a one-line description of w, made of its type name
+// followed by its name, CSS classes and label when it has some, like
+// `GtkButton#ok.suggested-action "OK"`.
+func Describe(w gtk.IWidget) string {
+	widget := w.ToWidget()
+	typeName := widget.TypeFromInstance().Name()
+
+	var b strings.Builder
+	b.WriteString(typeName)
+	if name, err := widget.GetName(); err == nil && name != typeName {
+		b.WriteString("#" + name)
+	}
+	if ctx, err := widget.GetStyleContext(); err == nil {
+		for _, class := range ctx.ListClasses() {
+			b.WriteString("." + class)
+		}
+	}
+	if label := Label(widget); label != "" {
+		fmt.Fprintf(&b, " %q", label)
+	}
+	return b.String()
+}
+
+// Dump writes the hierarchy under root to w, one widget per line indented
+// by its depth, with its description, accessible role and allocation. It
+// is meant to find out how to match a widget, or why it does not match.
+func Dump(w io.Writer, root gtk.IWidget) error {
+	var err error
+	Walk(root, func(widget *gtk.Widget, depth int) bool {
+		if err != nil {
+			return false
+		}
+		a := widget.GetAllocation()
+		visibility := ""
+		if !widget.GetVisible() {
+			visibility = " hidden"
+		}
+		_, err = fmt.Fprintf(w, "%s%s role=%q allocation=%dx%d+%d+%d%s\n",
+			strings.Repeat("  ", depth), Describe(widget), AccessibleRole(widget),
+			a.GetWidth(), a.GetHeight(), a.GetX(), a.GetY(), visibility)
+		return true
+	})
+	return err
+}
@@ -0,0 +1,182 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtktest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	gtk.Init(nil)
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"OK", "OK", true},
+		{"OK", "Ok", false},
+		{"", "", true},
+		{"*", "", true},
+		{"*", "Cancel", true},
+		{"C?ncel", "Cancel", true},
+		{"C?ncel", "Cncel", false},
+		{"*.txt", "notes.txt", true},
+		{"*.txt", "notes.txt.gz", false},
+		{"a*b*c", "abxbc", true},
+		{"a*b*c", "acb", false},
+		{"Sav?", "Savé", true},
+	}
+	for _, test := range tests {
+		if got := matchPattern(test.pattern, test.s); got != test.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", test.pattern, test.s, got, test.want)
+		}
+	}
+}
+
+// form is a window with a labelled entry and a button counting its clicks.
+type form struct {
+	window *gtk.Window
+	entry  *gtk.Entry
+	clicks int
+}
+
+func newForm(t *testing.T) *form {
+	f := &form{}
+	var err error
+	if f.window, err = gtk.WindowNew(gtk.WINDOW_TOPLEVEL); err != nil {
+		t.Fatal(err)
+	}
+	box, err := gtk.BoxNew(gtk.ORIENTATION_VERTICAL, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	label, err := gtk.LabelNew("Name:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.entry, err = gtk.EntryNew(); err != nil {
+		t.Fatal(err)
+	}
+	f.entry.SetName("name")
+	button, err := gtk.ButtonNewWithLabel("OK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := button.GetStyleContext()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.AddClass("suggested-action")
+	button.Connect("clicked", func() {
+		f.clicks++
+	})
+
+	box.Add(label)
+	box.Add(f.entry)
+	box.Add(button)
+	f.window.Add(box)
+	f.window.ShowAll()
+	if err := WaitFor(5*time.Second, f.window.GetMapped); err != nil {
+		f.window.Destroy()
+		t.Fatal("expected the window to be mapped:", err)
+	}
+	return f
+}
+
+func TestFind(t *testing.T) {
+	f := newForm(t)
+	defer f.window.Destroy()
+
+	entry, err := Find(f.window, ByName("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Native() != f.entry.Native() {
+		t.Error("expected to find the entry by name")
+	}
+	if _, err := Find(f.window, ByRole("text"), ByType(glib.TypeFromName("GtkEntry"))); err != nil {
+		t.Error(err)
+	}
+
+	button, err := Find(f.window, ByClass("suggested-action"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !button.IsA(glib.TypeFromName("GtkButton")) {
+		t.Errorf("expected a button, got %s", Describe(button))
+	}
+	if role := AccessibleRole(button); role != "push button" {
+		t.Errorf("expected the role of the button to be \"push button\", got %q", role)
+	}
+
+	labels := FindAll(f.window, ByTypeName("GtkLabel"), ByLabel("*"), Mapped())
+	if len(labels) != 2 {
+		t.Errorf("expected 2 labels, got %d", len(labels))
+	}
+	if _, err := Find(f.window, ByLabel("Cancel")); err == nil {
+		t.Error("expected no widget labelled Cancel")
+	}
+
+	var buf bytes.Buffer
+	if err := Dump(&buf, f.window); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"GtkWindow", "\n  GtkBox", "GtkEntry#name", ".suggested-action", `"OK" role="push button"`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected the dump to contain %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestClickAndType(t *testing.T) {
+	f := newForm(t)
+	defer f.window.Destroy()
+
+	button, err := Find(f.window, ByRole("push button"), ByLabel("O?"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Click(button); err != nil {
+		t.Fatal(err)
+	}
+	if err := WaitFor(5*time.Second, func() bool { return f.clicks == 1 }); err != nil {
+		t.Errorf("expected the button to be clicked once, got %d clicks", f.clicks)
+	}
+
+	if err := Type(f.entry, "Hello, World"); err != nil {
+		t.Fatal(err)
+	}
+	text := func() bool {
+		s, _ := f.entry.GetText()
+		return s == "Hello, World"
+	}
+	if err := WaitFor(5*time.Second, text); err != nil {
+		t.Error("expected the text to be typed in the entry")
+	}
+	if err := WaitIdle(5 * time.Second); err != nil {
+		t.Error(err)
+	}
+	AssertProperty(t, f.entry, "text", "Hello, World")
+	AssertProperty(t, f.entry, "is-focus", true)
+}
+
+func TestNotMapped(t *testing.T) {
+	button, err := gtk.ButtonNewWithLabel("Hidden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Click(button); err == nil {
+		t.Error("expected an error clicking an unmapped button")
+	}
+	if Label(button) != "Hidden" {
+		t.Errorf("expected the label Hidden, got %q", Label(button))
+	}
+}
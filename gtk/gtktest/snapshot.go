@@ -0,0 +1,233 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtktest
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// UpdateGoldens makes AssertSnapshot write the snapshots as the new golden
+// images instead of comparing them. It is set when the GTKTEST_UPDATE_GOLDENS
+// environment variable is not empty.
+var UpdateGoldens = os.Getenv("GTKTEST_UPDATE_GOLDENS") != ""
+
+// Snapshot renders w into an image surface of width by height pixels times
+// scale. w is allocated width by height pixels in an OffscreenWindow, and
+// drawn with Widget.Draw scaled by scale, like on a display of that scale
+// factor. It is then removed from the window. The minimum size of w must
+// fit in width by height pixels, and w must not have a parent.
+func Snapshot(w gtk.IWidget, width, height, scale int) (*cairo.Surface, error) {
+	if width <= 0 || height <= 0 || scale <= 0 {
+		return nil, fmt.Errorf("gtktest: invalid snapshot size %dx%d@%d", width, height, scale)
+	}
+	widget := w.ToWidget()
+
+	win, err := gtk.OffscreenWindowNew()
+	if err != nil {
+		return nil, err
+	}
+	defer win.Destroy()
+	win.SetSizeRequest(width, height)
+	win.Add(w)
+	defer win.Remove(w)
+	win.ShowAll()
+
+	minWidth, _ := widget.GetPreferredWidth()
+	minHeight, _ := widget.GetPreferredHeightForWidth(width)
+	if minWidth > width || minHeight > height {
+		return nil, fmt.Errorf("gtktest: %s needs at least %dx%d pixels, not %dx%d",
+			Describe(w), minWidth, minHeight, width, height)
+	}
+	allocated := func() bool {
+		return widget.GetMapped() &&
+			widget.GetAllocatedWidth() == width && widget.GetAllocatedHeight() == height
+	}
+	if err := WaitFor(5*time.Second, allocated); err != nil {
+		return nil, fmt.Errorf("gtktest: %s was allocated %dx%d instead of %dx%d",
+			Describe(w), widget.GetAllocatedWidth(), widget.GetAllocatedHeight(), width, height)
+	}
+
+	surface := cairo.CreateImageSurface(cairo.FORMAT_ARGB32, width*scale, height*scale)
+	if status := surface.Status(); status != cairo.STATUS_SUCCESS {
+		return nil, cairo.ErrorStatus(status)
+	}
+	cr := cairo.Create(surface)
+	cr.Scale(float64(scale), float64(scale))
+	widget.Draw(cr)
+	surface.Flush()
+	return surface, nil
+}
+
+// SurfaceImage copies the pixels of an ARGB32 image surface, such as one
+// returned by Snapshot, into an image.
+func SurfaceImage(surface *cairo.Surface) (*image.RGBA, error) {
+	if f := surface.GetFormat(); f != cairo.FORMAT_ARGB32 {
+		return nil, fmt.Errorf("gtktest: unsupported surface format %d", f)
+	}
+	surface.Flush()
+	width, height, stride := surface.GetWidth(), surface.GetHeight(), surface.GetStride()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	data := surface.GetData()
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// Each pixel is a premultiplied ARGB value in native byte
+			// order, as are those of image.RGBA once split in bytes.
+			p := *(*uint32)(unsafe.Pointer(uintptr(data) + uintptr(y*stride+x*4)))
+			i := img.PixOffset(x, y)
+			img.Pix[i+0] = uint8(p >> 16)
+			img.Pix[i+1] = uint8(p >> 8)
+			img.Pix[i+2] = uint8(p)
+			img.Pix[i+3] = uint8(p >> 24)
+		}
+	}
+	return img, nil
+}
+
+// CompareImages compares got and want pixel by pixel. A pixel differs when
+// one of its premultiplied 8-bit channels differs by more than tolerance.
+// It returns the number of differing pixels, and an image of the
+// differences showing a faded want with the differing pixels in red. It
+// fails if the images are not of the same size.
+func CompareImages(got, want image.Image, tolerance uint8) (int, *image.NRGBA, error) {
+	gb, wb := got.Bounds(), want.Bounds()
+	if gb.Dx() != wb.Dx() || gb.Dy() != wb.Dy() {
+		return 0, nil, fmt.Errorf("gtktest: got an image of %dx%d instead of %dx%d",
+			gb.Dx(), gb.Dy(), wb.Dx(), wb.Dy())
+	}
+	diff := image.NewNRGBA(image.Rect(0, 0, wb.Dx(), wb.Dy()))
+	differing := 0
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			gc := got.At(gb.Min.X+x, gb.Min.Y+y)
+			wc := want.At(wb.Min.X+x, wb.Min.Y+y)
+			if colorsDiffer(gc, wc, tolerance) {
+				differing++
+				diff.SetNRGBA(x, y, color.NRGBA{R: 0xff, A: 0xff})
+				continue
+			}
+			g := color.GrayModel.Convert(wc).(color.Gray)
+			diff.SetNRGBA(x, y, color.NRGBA{R: g.Y, G: g.Y, B: g.Y, A: 0x40})
+		}
+	}
+	return differing, diff, nil
+}
+
+func colorsDiffer(a, b color.Color, tolerance uint8) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	for _, c := range [][2]uint32{{ar, br}, {ag, bg}, {ab, bb}, {aa, ba}} {
+		d := int(c[0]>>8) - int(c[1]>>8)
+		if d > int(tolerance) || -d > int(tolerance) {
+			return true
+		}
+	}
+	return false
+}
+
+// SnapshotOptions are the rendering and comparison settings of
+// AssertSnapshot.
+type SnapshotOptions struct {
+	// Width and Height are the size of the widget, in pixels.
+	Width, Height int
+	// Scale is the scale factor of the rendering, 1 if zero.
+	Scale int
+	// Tolerance is the largest difference of a channel of a pixel, from 0
+	// to 255, by which a pixel is still equal to the golden one.
+	Tolerance uint8
+	// MaxDiffPixels is the number of pixels allowed to differ.
+	MaxDiffPixels int
+}
+
+// AssertSnapshot renders w with Snapshot, and compares the rendering with
+// the golden PNG image at the path golden with CompareImages. On failure,
+// the rendering and the image of the differences are written next to the
+// golden image, with the ".got.png" and ".diff.png" extensions, to be
+// looked at or kept as artifacts of a CI job. The golden image is written
+// instead when UpdateGoldens is set.
+func AssertSnapshot(t testing.TB, w gtk.IWidget, golden string, opts SnapshotOptions) {
+	t.Helper()
+	scale := opts.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	surface, err := Snapshot(w, opts.Width, opts.Height, scale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := strings.TrimSuffix(golden, ".png")
+	gotPath, diffPath := base+".got.png", base+".diff.png"
+	// Files left by a previous failure would be mistaken for the ones of
+	// this run.
+	os.Remove(gotPath)
+	os.Remove(diffPath)
+
+	if UpdateGoldens {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := surface.WriteToPNG(golden); err != nil {
+			t.Fatalf("gtktest: writing %s: %v", golden, err)
+		}
+		t.Logf("gtktest: updated %s", golden)
+		return
+	}
+
+	want, err := readPNG(golden)
+	if err != nil {
+		t.Fatalf("gtktest: %v (set GTKTEST_UPDATE_GOLDENS=1 to create it)", err)
+	}
+	got, err := SurfaceImage(surface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	differing, diff, err := CompareImages(got, want, opts.Tolerance)
+	if err == nil && differing <= opts.MaxDiffPixels {
+		return
+	}
+
+	if werr := surface.WriteToPNG(gotPath); werr != nil {
+		t.Logf("gtktest: writing %s: %v", gotPath, werr)
+	}
+	if err != nil {
+		t.Errorf("%v, see %s", err, gotPath)
+		return
+	}
+	if werr := writePNG(diffPath, diff); werr != nil {
+		t.Logf("gtktest: writing %s: %v", diffPath, werr)
+	}
+	t.Errorf("gtktest: %d pixels of %s differ from %s, %d allowed, see %s and %s",
+		differing, Describe(w), golden, opts.MaxDiffPixels, gotPath, diffPath)
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
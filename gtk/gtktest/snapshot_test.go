@@ -0,0 +1,168 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtktest
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// halves is a widget painted with a color on its left half and another one
+// on its right half.
+type halves struct {
+	left, right [3]float64
+}
+
+func (h *halves) GetPreferredWidth(widget *gtk.Widget) (int, int) {
+	return 2, 2
+}
+
+func (h *halves) GetPreferredHeight(widget *gtk.Widget) (int, int) {
+	return 1, 1
+}
+
+func (h *halves) Draw(widget *gtk.Widget, cr *cairo.Context) bool {
+	width, height := float64(widget.GetAllocatedWidth()), float64(widget.GetAllocatedHeight())
+	cr.SetSourceRGB(h.left[0], h.left[1], h.left[2])
+	cr.Rectangle(0, 0, width/2, height)
+	cr.Fill()
+	cr.SetSourceRGB(h.right[0], h.right[1], h.right[2])
+	cr.Rectangle(width/2, 0, width/2, height)
+	cr.Fill()
+	return true
+}
+
+func newHalves(t *testing.T, left, right [3]float64) *gtk.GoWidget {
+	w, err := gtk.GoWidgetNew(&halves{left: left, right: right})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+var (
+	red  = [3]float64{1, 0, 0}
+	blue = [3]float64{0, 0, 1}
+)
+
+func TestAssertSnapshot(t *testing.T) {
+	w := newHalves(t, red, blue)
+	AssertSnapshot(t, w, "testdata/halves.png", SnapshotOptions{Width: 40, Height: 20})
+	AssertSnapshot(t, w, "testdata/halves@2x.png", SnapshotOptions{Width: 40, Height: 20, Scale: 2})
+}
+
+func TestSnapshotTooSmall(t *testing.T) {
+	label, err := gtk.LabelNew("A label much wider than two pixels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Snapshot(label, 2, 2, 1); err == nil {
+		t.Error("expected an error for a size below the minimum size of the widget")
+	}
+}
+
+// recorder records the errors of a test instead of failing it.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertSnapshotMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gtktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	golden := filepath.Join(dir, "halves.png")
+	data, err := ioutil.ReadFile("testdata/halves.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(golden, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The right half is a dark blue, only within tolerance of the golden blue.
+	w := newHalves(t, red, [3]float64{0, 0, 0.98})
+	r := &recorder{TB: t}
+	AssertSnapshot(r, w, golden, SnapshotOptions{Width: 40, Height: 20, Tolerance: 8})
+	if len(r.errors) != 0 {
+		t.Errorf("expected the snapshot to match within tolerance, got %v", r.errors)
+	}
+
+	// The left half now differs.
+	w = newHalves(t, [3]float64{0, 1, 0}, blue)
+	AssertSnapshot(r, w, golden, SnapshotOptions{Width: 40, Height: 20, MaxDiffPixels: 10})
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "400 pixels") {
+		t.Fatalf("expected 400 differing pixels to be reported, got %v", r.errors)
+	}
+
+	got, err := readPNG(filepath.Join(dir, "halves.got.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := color.NRGBAModel.Convert(got.At(0, 0)).(color.NRGBA); c != (color.NRGBA{G: 0xff, A: 0xff}) {
+		t.Errorf("expected the rendering to be written, got %v at (0, 0)", c)
+	}
+	diff, err := readPNG(filepath.Join(dir, "halves.diff.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := color.NRGBAModel.Convert(diff.At(5, 5)).(color.NRGBA); c != (color.NRGBA{R: 0xff, A: 0xff}) {
+		t.Errorf("expected a differing pixel to be red in the diff, got %v", c)
+	}
+	if _, _, _, a := diff.At(35, 5).RGBA(); a>>8 != 0x40 {
+		t.Errorf("expected a matching pixel to be faded in the diff, got an alpha of %d", a>>8)
+	}
+}
+
+func TestCompareImages(t *testing.T) {
+	want := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	got := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			want.SetNRGBA(x, y, color.NRGBA{R: 100, G: 150, B: 200, A: 255})
+			got.SetRGBA(x, y, color.RGBA{R: 100, G: 150, B: 200, A: 255})
+		}
+	}
+	got.SetRGBA(1, 0, color.RGBA{R: 104, G: 150, B: 200, A: 255})
+	got.SetRGBA(2, 1, color.RGBA{R: 100, G: 150, B: 200, A: 200})
+
+	tests := []struct {
+		tolerance uint8
+		want      int
+	}{
+		{0, 2},
+		{4, 1},
+		{55, 0},
+	}
+	for _, test := range tests {
+		n, diff, err := CompareImages(got, want, test.tolerance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != test.want {
+			t.Errorf("expected %d differing pixels with a tolerance of %d, got %d", test.want, test.tolerance, n)
+		}
+		if diff.Bounds() != want.Bounds() {
+			t.Errorf("expected a diff of %v, got %v", want.Bounds(), diff.Bounds())
+		}
+	}
+
+	if _, _, err := CompareImages(image.NewRGBA(image.Rect(0, 0, 3, 2)), want, 0); err == nil {
+		t.Error("expected an error comparing images of different sizes")
+	}
+}
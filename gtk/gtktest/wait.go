@@ -0,0 +1,59 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtktest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// ErrTimeout is returned when the waited for condition is not met in time.
+var ErrTimeout = errors.New("gtktest: timed out")
+
+// WaitFor runs iterations of the GTK main loop until cond returns true, or
+// fails with ErrTimeout once timeout has elapsed.
+func WaitFor(timeout time.Duration, cond func() bool) error {
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return ErrTimeout
+		}
+		if gtk.EventsPending() {
+			gtk.MainIterationDo(false)
+		} else {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// WaitIdle waits for the display to handle the requests sent to it, such
+// as simulated events, then runs iterations of the GTK main loop until no
+// events are pending. It fails with ErrTimeout once timeout has elapsed.
+func WaitIdle(timeout time.Duration) error {
+	if display, err := gdk.DisplayGetDefault(); err == nil {
+		display.Sync()
+	}
+	return WaitFor(timeout, func() bool {
+		return !gtk.EventsPending()
+	})
+}
+
+// AssertProperty fails t unless the property name of w is equal to want,
+// as compared by reflect.DeepEqual.
+func AssertProperty(t testing.TB, w gtk.IWidget, name string, want interface{}) {
+	t.Helper()
+	got, err := w.ToWidget().GetProperty(name)
+	if err != nil {
+		t.Errorf("%s: property %q: %v", Describe(w), name, err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s: expected property %q to be %#v, got %#v", Describe(w), name, want, got)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"unsafe"
 
+	"github.com/gotk3/gotk3/cairo"
 	"github.com/gotk3/gotk3/gdk"
 	"github.com/gotk3/gotk3/glib"
 )
@@ -447,14 +448,29 @@ func (v *IconView) GetItemRow(path *TreePath) int {
 
 // TODO:
 // gtk_icon_view_get_item_column().
-// gtk_icon_view_enable_model_drag_source().
-// func (v *IconView) EnableModelDragSource() {}
-// gtk_icon_view_enable_model_drag_dest().
-// func (v *IconView) EnableModelDragDest() {}
-// gtk_icon_view_unset_model_drag_source().
-// func (v *IconView) UnsetModelDragSource() {}
-// gtk_icon_view_unset_model_drag_dest().
-// func (v *IconView) UnsetModelDragDest() {}
+
+// EnableModelDragSource is a wrapper around gtk_icon_view_enable_model_drag_source().
+func (v *IconView) EnableModelDragSource(startButtonMask gdk.ModifierType, targets []TargetEntry, actions gdk.DragAction) {
+	ctargets, n := targetEntriesNative(targets)
+	C.gtk_icon_view_enable_model_drag_source(v.native(), C.GdkModifierType(startButtonMask),
+		ctargets, n, C.GdkDragAction(actions))
+}
+
+// EnableModelDragDest is a wrapper around gtk_icon_view_enable_model_drag_dest().
+func (v *IconView) EnableModelDragDest(targets []TargetEntry, actions gdk.DragAction) {
+	ctargets, n := targetEntriesNative(targets)
+	C.gtk_icon_view_enable_model_drag_dest(v.native(), ctargets, n, C.GdkDragAction(actions))
+}
+
+// UnsetModelDragSource is a wrapper around gtk_icon_view_unset_model_drag_source().
+func (v *IconView) UnsetModelDragSource() {
+	C.gtk_icon_view_unset_model_drag_source(v.native())
+}
+
+// UnsetModelDragDest is a wrapper around gtk_icon_view_unset_model_drag_dest().
+func (v *IconView) UnsetModelDragDest() {
+	C.gtk_icon_view_unset_model_drag_dest(v.native())
+}
 
 // SetReorderable is a wrapper around gtk_icon_view_set_reorderable().
 func (v *IconView) SetReorderable(reorderable bool) {
@@ -466,12 +482,55 @@ func (v *IconView) GetReorderable() bool {
 	return gobool(C.gtk_icon_view_get_reorderable(v.native()))
 }
 
-// TODO:
-// gtk_icon_view_set_drag_dest_item().
-// func (v *IconView) SetDragDestItem() {}
-// gtk_icon_view_get_drag_dest_item().
-// func (v *IconView) GetDragDestItem() {}
-// gtk_icon_view_get_dest_item_at_pos().
-// func (v *IconView) GetDestItemAtPos() {}
-// gtk_icon_view_create_drag_icon().
-// func (v *IconView) CreateDragIcon() {}
+// SetDragDestItem is a wrapper around gtk_icon_view_set_drag_dest_item().
+// A nil path removes the highlight.
+func (v *IconView) SetDragDestItem(path *TreePath, pos IconViewDropPosition) {
+	C.gtk_icon_view_set_drag_dest_item(v.native(), path.native(), C.GtkIconViewDropPosition(pos))
+}
+
+// GetDragDestItem is a wrapper around gtk_icon_view_get_drag_dest_item().
+// The returned path is nil if no item is highlighted.
+func (v *IconView) GetDragDestItem() (*TreePath, IconViewDropPosition) {
+	var (
+		cpath *C.GtkTreePath
+		pos   C.GtkIconViewDropPosition
+		path  *TreePath
+	)
+
+	C.gtk_icon_view_get_drag_dest_item(v.native(), &cpath, &pos)
+
+	if cpath != nil {
+		path = &TreePath{cpath}
+		runtime.SetFinalizer(path, (*TreePath).free)
+	}
+
+	return path, IconViewDropPosition(pos)
+}
+
+// GetDestItemAtPos is a wrapper around gtk_icon_view_get_dest_item_at_pos().
+// It returns false if there is no item at the position.
+func (v *IconView) GetDestItemAtPos(dragX, dragY int) (*TreePath, IconViewDropPosition, bool) {
+	var (
+		cpath *C.GtkTreePath
+		pos   C.GtkIconViewDropPosition
+		path  *TreePath
+	)
+
+	ok := gobool(C.gtk_icon_view_get_dest_item_at_pos(v.native(), C.gint(dragX), C.gint(dragY), &cpath, &pos))
+
+	if cpath != nil {
+		path = &TreePath{cpath}
+		runtime.SetFinalizer(path, (*TreePath).free)
+	}
+
+	return path, IconViewDropPosition(pos), ok
+}
+
+// CreateDragIcon is a wrapper around gtk_icon_view_create_drag_icon().
+func (v *IconView) CreateDragIcon(path *TreePath) (*cairo.Surface, error) {
+	c := C.gtk_icon_view_create_drag_icon(v.native(), path.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return cairo.NewSurface(uintptr(unsafe.Pointer(c)), false), nil
+}
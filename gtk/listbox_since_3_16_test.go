@@ -0,0 +1,67 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!glib_2_40,!glib_2_42
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestListBoxBindGoListModel(t *testing.T) {
+	names := []string{"one", "two", "three"}
+	model, err := glib.GoListModelNewFromSlice(&names)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	box, err := gtk.ListBoxNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	box.BindModel(&model.ListModel, func(item *glib.Object, userData ...interface{}) gtk.IWidget {
+		v, ok := glib.GoListItemValue(item)
+		if !ok {
+			t.Error("item is not a GotkListItem")
+			return nil
+		}
+		label, err := gtk.LabelNew(v.(string) + userData[0].(string))
+		if err != nil {
+			t.Error(err)
+			return nil
+		}
+		return label
+	}, "!")
+
+	for i, name := range names {
+		row := box.GetRowAtIndex(i)
+		if row == nil {
+			t.Fatalf("missing row %d", i)
+		}
+		child, err := row.GetChild()
+		if err != nil {
+			t.Fatal(err)
+		}
+		label, ok := child.(*gtk.Label)
+		if !ok {
+			t.Fatalf("expected a *gtk.Label, got %T", child)
+		}
+		if text, _ := label.GetText(); text != name+"!" {
+			t.Errorf("expected row text %q, got %q", name+"!", text)
+		}
+	}
+
+	names = append(names, "four")
+	model.ItemsChanged(3, 0, 1)
+	if box.GetRowAtIndex(3) == nil {
+		t.Error("expected a row for the appended item")
+	}
+
+	box.BindModel(nil, nil)
+	if box.GetRowAtIndex(0) != nil {
+		t.Error("expected rows to be removed after unbinding")
+	}
+}
@@ -375,8 +375,7 @@ func PageSetupNewFromFile(fileName string) (*PageSetup, error) {
 	var err *C.GError = nil
 	c := C.gtk_page_setup_new_from_file((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	obj := glib.Take(unsafe.Pointer(c))
 	return &PageSetup{obj}, nil
@@ -384,6 +383,20 @@ func PageSetupNewFromFile(fileName string) (*PageSetup, error) {
 }
 
 // PageSetupNewFromKeyFile() is a wrapper around gtk_page_setup_new_from_key_file().
+// The page setup is read from the group groupName, or from the "Page Setup"
+// group if groupName is empty.
+func PageSetupNewFromKeyFile(keyFile *glib.KeyFile, groupName string) (*PageSetup, error) {
+	cgroup := keyFileGroup(groupName)
+	defer C.free(unsafe.Pointer(cgroup))
+	var err *C.GError = nil
+	c := C.gtk_page_setup_new_from_key_file(keyFileNative(keyFile), cgroup, &err)
+	if c == nil {
+		return nil, glib.TakeError(unsafe.Pointer(err))
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPageSetup(obj), nil
+}
 
 // PageSetupLoadFile() is a wrapper around gtk_page_setup_load_file().
 func (ps *PageSetup) PageSetupLoadFile(name string) error {
@@ -392,13 +405,22 @@ func (ps *PageSetup) PageSetupLoadFile(name string) error {
 	var err *C.GError = nil
 	res := C.gtk_page_setup_load_file(ps.native(), cstr, &err)
 	if !gobool(res) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
 
 // PageSetupLoadKeyFile() is a wrapper around gtk_page_setup_load_key_file().
+func (ps *PageSetup) PageSetupLoadKeyFile(keyFile *glib.KeyFile, groupName string) error {
+	cgroup := keyFileGroup(groupName)
+	defer C.free(unsafe.Pointer(cgroup))
+	var err *C.GError = nil
+	res := C.gtk_page_setup_load_key_file(ps.native(), keyFileNative(keyFile), cgroup, &err)
+	if !gobool(res) {
+		return glib.TakeError(unsafe.Pointer(err))
+	}
+	return nil
+}
 
 // PageSetupToFile() is a wrapper around gtk_page_setup_to_file().
 func (ps *PageSetup) PageSetupToFile(name string) error {
@@ -407,13 +429,17 @@ func (ps *PageSetup) PageSetupToFile(name string) error {
 	var err *C.GError = nil
 	res := C.gtk_page_setup_to_file(ps.native(), cstr, &err)
 	if !gobool(res) {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
 
 // PageSetupToKeyFile() is a wrapper around gtk_page_setup_to_key_file().
+func (ps *PageSetup) PageSetupToKeyFile(keyFile *glib.KeyFile, groupName string) {
+	cgroup := keyFileGroup(groupName)
+	defer C.free(unsafe.Pointer(cgroup))
+	C.gtk_page_setup_to_key_file(ps.native(), keyFileNative(keyFile), cgroup)
+}
 
 /*
  * GtkPaperSize
@@ -778,8 +804,7 @@ func (po *PrintOperation) PrintOperationSetAllowAsync(allowSync bool) {
 func (po *PrintOperation) PrintOperationGetError() error {
 	var err *C.GError = nil
 	C.gtk_print_operation_get_error(po.native(), &err)
-	defer C.g_error_free(err)
-	return errors.New(C.GoString((*C.char)(err.message)))
+	return glib.TakeError(unsafe.Pointer(err))
 }
 
 // SetDefaultPageSetup() is a wrapper around gtk_print_operation_set_default_page_setup().
@@ -869,14 +894,19 @@ func (po *PrintOperation) SetCustomTabLabel(label string) {
 	C.gtk_print_operation_set_custom_tab_label(po.native(), (*C.gchar)(cstr))
 }
 
-// Run() is a wrapper around gtk_print_operation_run().
+// Run() is a wrapper around gtk_print_operation_run(). parent may be nil,
+// as when exporting to a file with PRINT_OPERATION_ACTION_EXPORT.
 func (po *PrintOperation) Run(action PrintOperationAction, parent IWindow) (PrintOperationResult, error) {
+	var w *C.GtkWindow = nil
+	if parent != nil {
+		w = parent.toWindow()
+	}
+
 	var err *C.GError = nil
-	c := C.gtk_print_operation_run(po.native(), C.GtkPrintOperationAction(action), parent.toWindow(), &err)
+	c := C.gtk_print_operation_run(po.native(), C.GtkPrintOperationAction(action), w, &err)
 	res := PrintOperationResult(c)
 	if res == PRINT_OPERATION_RESULT_ERROR {
-		defer C.g_error_free(err)
-		return res, errors.New(C.GoString((*C.char)(err.message)))
+		return res, glib.TakeError(unsafe.Pointer(err))
 	}
 	return res, nil
 }
@@ -947,6 +977,60 @@ func (po *PrintOperation) GetEmbedPageSetup() bool {
 	return gobool(c)
 }
 
+// ConnectBeginPrint connects f to the "begin-print" signal, emitted once the
+// print settings are known and before the document is paginated. f
+// typically lays the document out for the size of context and sets the
+// number of pages with SetNPages.
+func (po *PrintOperation) ConnectBeginPrint(f func(context *PrintContext)) (glib.SignalHandle, error) {
+	return po.Connect("begin-print", func(_ interface{}, context *PrintContext) {
+		f(context)
+	})
+}
+
+// ConnectPaginate connects f to the "paginate" signal, emitted after
+// "begin-print" until f returns true. f may split the document into pages a
+// few at a time, updating SetNPages as it goes.
+func (po *PrintOperation) ConnectPaginate(f func(context *PrintContext) bool) (glib.SignalHandle, error) {
+	return po.Connect("paginate", func(_ interface{}, context *PrintContext) bool {
+		return f(context)
+	})
+}
+
+// ConnectRequestPageSetup connects f to the "request-page-setup" signal,
+// emitted before each page is drawn. f may change setup, for instance to
+// print a page in landscape, which only affects the page pageNr.
+func (po *PrintOperation) ConnectRequestPageSetup(f func(context *PrintContext, pageNr int, setup *PageSetup)) (glib.SignalHandle, error) {
+	return po.Connect("request-page-setup", func(_ interface{}, context *PrintContext, pageNr int, setup *PageSetup) {
+		f(context, pageNr, setup)
+	})
+}
+
+// ConnectDrawPage connects f to the "draw-page" signal, emitted for each
+// page to print. f draws the page pageNr, counted from 0, on the cairo
+// context of context.
+func (po *PrintOperation) ConnectDrawPage(f func(context *PrintContext, pageNr int)) (glib.SignalHandle, error) {
+	return po.Connect("draw-page", func(_ interface{}, context *PrintContext, pageNr int) {
+		f(context, pageNr)
+	})
+}
+
+// ConnectEndPrint connects f to the "end-print" signal, emitted once all the
+// pages have been drawn, for f to release what "begin-print" allocated.
+func (po *PrintOperation) ConnectEndPrint(f func(context *PrintContext)) (glib.SignalHandle, error) {
+	return po.Connect("end-print", func(_ interface{}, context *PrintContext) {
+		f(context)
+	})
+}
+
+// ConnectDone connects f to the "done" signal, emitted when the operation
+// is over, with the same result Run returns when it does not run
+// asynchronously.
+func (po *PrintOperation) ConnectDone(f func(result PrintOperationResult)) (glib.SignalHandle, error) {
+	return po.Connect("done", func(_ interface{}, result PrintOperationResult) {
+		f(result)
+	})
+}
+
 // PrintRunPageSetupDialog() is a wrapper around gtk_print_run_page_setup_dialog().
 func PrintRunPageSetupDialog(parent IWindow, pageSetup *PageSetup, settings *PrintSettings) *PageSetup {
 	c := C.gtk_print_run_page_setup_dialog(parent.toWindow(), pageSetup.native(), settings.native())
@@ -1568,14 +1652,27 @@ func PrintSettingsNewFromFile(name string) (*PrintSettings, error) {
 	var err *C.GError = nil
 	c := C.gtk_print_settings_new_from_file((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(C.GoString((*C.char)(err.message)))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	obj := glib.Take(unsafe.Pointer(c))
 	return wrapPrintSettings(obj), nil
 }
 
 // PrintSettingsNewFromKeyFile() is a wrapper around gtk_print_settings_new_from_key_file().
+// The settings are read from the group groupName, or from the
+// "Print Settings" group if groupName is empty.
+func PrintSettingsNewFromKeyFile(keyFile *glib.KeyFile, groupName string) (*PrintSettings, error) {
+	cgroup := keyFileGroup(groupName)
+	defer C.free(unsafe.Pointer(cgroup))
+	var err *C.GError = nil
+	c := C.gtk_print_settings_new_from_key_file(keyFileNative(keyFile), cgroup, &err)
+	if c == nil {
+		return nil, glib.TakeError(unsafe.Pointer(err))
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPrintSettings(obj), nil
+}
 
 // LoadFile() is a wrapper around gtk_print_settings_load_file().
 func (ps *PrintSettings) LoadFile(name string) error {
@@ -1584,13 +1681,24 @@ func (ps *PrintSettings) LoadFile(name string) error {
 	var err *C.GError = nil
 	c := C.gtk_print_settings_load_file(ps.native(), (*C.gchar)(cstr), &err)
 	if gobool(c) == false {
-		defer C.g_error_free(err)
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
 
-// LoadKeyFile() is a wrapper around gtk_print_settings_load_key_file().
+// LoadKeyFile() is a wrapper around gtk_print_settings_load_key_file(). It
+// replaces the settings with those of the group groupName, or of the
+// "Print Settings" group if groupName is empty.
+func (ps *PrintSettings) LoadKeyFile(keyFile *glib.KeyFile, groupName string) error {
+	cgroup := keyFileGroup(groupName)
+	defer C.free(unsafe.Pointer(cgroup))
+	var err *C.GError = nil
+	c := C.gtk_print_settings_load_key_file(ps.native(), keyFileNative(keyFile), cgroup, &err)
+	if gobool(c) == false {
+		return glib.TakeError(unsafe.Pointer(err))
+	}
+	return nil
+}
 
 // ToFile() is a wrapper around gtk_print_settings_to_file().
 func (ps *PrintSettings) ToFile(name string) error {
@@ -1599,9 +1707,40 @@ func (ps *PrintSettings) ToFile(name string) error {
 	var err *C.GError = nil
 	c := C.gtk_print_settings_to_file(ps.native(), (*C.gchar)(cstr), &err)
 	if gobool(c) == false {
-		return errors.New(C.GoString((*C.char)(err.message)))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
 
-// ToKeyFile() is a wrapper around gtk_print_settings_to_key_file().
+// ToKeyFile() is a wrapper around gtk_print_settings_to_key_file(). The
+// settings are written to the group groupName, or to the "Print Settings"
+// group if groupName is empty.
+func (ps *PrintSettings) ToKeyFile(keyFile *glib.KeyFile, groupName string) {
+	cgroup := keyFileGroup(groupName)
+	defer C.free(unsafe.Pointer(cgroup))
+	C.gtk_print_settings_to_key_file(ps.native(), keyFileNative(keyFile), cgroup)
+}
+
+// ToMap returns the settings as a map of their keys to their values, as
+// listed by ForEach.
+func (ps *PrintSettings) ToMap() map[string]string {
+	m := make(map[string]string)
+	ps.ForEach(func(key, value string, userData ...interface{}) {
+		m[key] = value
+	})
+	return m
+}
+
+// keyFileNative returns the GKeyFile of keyFile.
+func keyFileNative(keyFile *glib.KeyFile) *C.GKeyFile {
+	return (*C.GKeyFile)(unsafe.Pointer(keyFile.Native()))
+}
+
+// keyFileGroup returns groupName as a C string to be freed, or nil for the
+// default group of GTK if groupName is empty.
+func keyFileGroup(groupName string) *C.gchar {
+	if groupName == "" {
+		return nil
+	}
+	return (*C.gchar)(C.CString(groupName))
+}
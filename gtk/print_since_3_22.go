@@ -0,0 +1,53 @@
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!gtk_3_18,!gtk_3_20
+// Supports building with gtk 3.22+
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// ToGVariant is a wrapper around gtk_print_settings_to_gvariant(). The
+// returned variant is an a{sv} dictionary of the settings.
+func (ps *PrintSettings) ToGVariant() *glib.Variant {
+	c := C.gtk_print_settings_to_gvariant(ps.native())
+	return glib.TakeVariant(unsafe.Pointer(c))
+}
+
+// PrintSettingsNewFromGVariant is a wrapper around
+// gtk_print_settings_new_from_gvariant(). variant must be an a{sv}
+// dictionary, as returned by PrintSettings.ToGVariant.
+func PrintSettingsNewFromGVariant(variant *glib.Variant) (*PrintSettings, error) {
+	c := C.gtk_print_settings_new_from_gvariant((*C.GVariant)(unsafe.Pointer(variant.Native())))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPrintSettings(obj), nil
+}
+
+// ToGVariant is a wrapper around gtk_page_setup_to_gvariant(). The
+// returned variant is an a{sv} dictionary of the page setup.
+func (ps *PageSetup) ToGVariant() *glib.Variant {
+	c := C.gtk_page_setup_to_gvariant(ps.native())
+	return glib.TakeVariant(unsafe.Pointer(c))
+}
+
+// PageSetupNewFromGVariant is a wrapper around
+// gtk_page_setup_new_from_gvariant(). variant must be an a{sv} dictionary,
+// as returned by PageSetup.ToGVariant.
+func PageSetupNewFromGVariant(variant *glib.Variant) (*PageSetup, error) {
+	c := C.gtk_page_setup_new_from_gvariant((*C.GVariant)(unsafe.Pointer(variant.Native())))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPageSetup(obj), nil
+}
@@ -0,0 +1,52 @@
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16,!gtk_3_18,!gtk_3_20
+
+package gtk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrintSettingsGVariantRoundTrip(t *testing.T) {
+	settings, want := newTestPrintSettings(t)
+	variant := settings.ToGVariant()
+	if variant == nil {
+		t.Fatal("expected a variant")
+	}
+	if s := variant.TypeString(); s != "a{sv}" {
+		t.Errorf("expected a variant of type a{sv}, got %s", s)
+	}
+
+	loaded, err := PrintSettingsNewFromGVariant(variant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.ToMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPageSetupGVariantRoundTrip(t *testing.T) {
+	setup, err := PageSetupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paper, err := PaperSizeNew(PAPER_NAME_LETTER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup.SetPaperSize(paper)
+	setup.SetOrientation(PAGE_ORIENTATION_LANDSCAPE)
+	setup.SetTopMargin(12, GTK_UNIT_MM)
+	setup.SetLeftMargin(7.5, GTK_UNIT_MM)
+
+	variant := setup.ToGVariant()
+	if variant == nil {
+		t.Fatal("expected a variant")
+	}
+	loaded, err := PageSetupNewFromGVariant(variant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPageSetup(t, loaded, PAPER_NAME_LETTER)
+}
@@ -1,7 +1,15 @@
 package gtk
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"testing"
+
+	"github.com/gotk3/gotk3/glib"
 )
 
 func init() {
@@ -34,6 +42,97 @@ func TestPrintOperation(t *testing.T) {
 	}
 }
 
+// TestPrintOperationExportPDF tests printing a paginated document to a PDF
+// file, driven by the typed signal callbacks.
+func TestPrintOperationExportPDF(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotk3-print")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "invoice.pdf")
+
+	op, err := PrintOperationNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	op.SetExportFilename(filename)
+	op.SetJobName("invoice")
+
+	const lines, linesPerPage = 25, 10
+	var (
+		events    []string
+		paginated int
+		drawn     []int
+		result    PrintOperationResult = -1
+	)
+	op.ConnectBeginPrint(func(context *PrintContext) {
+		if context.GetWidth() <= 0 || context.GetHeight() <= 0 {
+			t.Error("expected a printable area")
+		}
+		events = append(events, "begin-print")
+	})
+	op.ConnectPaginate(func(context *PrintContext) bool {
+		// One page at a time.
+		paginated += linesPerPage
+		if paginated >= lines {
+			op.SetNPages((lines + linesPerPage - 1) / linesPerPage)
+			events = append(events, "paginate")
+			return true
+		}
+		return false
+	})
+	op.ConnectRequestPageSetup(func(context *PrintContext, pageNr int, setup *PageSetup) {
+		if pageNr == 1 {
+			setup.SetOrientation(PAGE_ORIENTATION_LANDSCAPE)
+		}
+	})
+	op.ConnectDrawPage(func(context *PrintContext, pageNr int) {
+		cr := context.GetCairoContext()
+		for i := pageNr * linesPerPage; i < lines && i < (pageNr+1)*linesPerPage; i++ {
+			cr.Rectangle(0, float64(i%linesPerPage)*12, context.GetWidth(), 10)
+		}
+		cr.Fill()
+		drawn = append(drawn, pageNr)
+	})
+	op.ConnectEndPrint(func(context *PrintContext) {
+		events = append(events, "end-print")
+	})
+	op.ConnectDone(func(r PrintOperationResult) {
+		result = r
+	})
+
+	res, err := op.Run(PRINT_OPERATION_ACTION_EXPORT, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != PRINT_OPERATION_RESULT_APPLY || result != res {
+		t.Errorf("expected the operation to be applied, got %v (done: %v)", res, result)
+	}
+	if !reflect.DeepEqual(events, []string{"begin-print", "paginate", "end-print"}) {
+		t.Errorf("unexpected signals %v", events)
+	}
+	if !reflect.DeepEqual(drawn, []int{0, 1, 2}) {
+		t.Errorf("expected the 3 pages to be drawn, got %v", drawn)
+	}
+
+	pdf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Fatal("expected a PDF file")
+	}
+	// Page objects are only readable as long as cairo does not compress
+	// them into object streams.
+	if !bytes.Contains(pdf, []byte("/ObjStm")) {
+		pages := regexp.MustCompile(`/Type\s*/Page\b`).FindAll(pdf, -1)
+		if len(pages) != 3 {
+			t.Errorf("expected 3 pages in the PDF, got %d", len(pages))
+		}
+	}
+}
+
 // TestPrintOperationPreview tests creating and manipulating PrintOperationPreview
 
 // TestPrintSettings tests creating and manipulating PrintSettings
@@ -52,4 +151,119 @@ func TestPrintSettings(t *testing.T) {
 	}, 0)
 }
 
+// newTestPrintSettings returns settings with a few keys set, along with
+// the map of those keys.
+func newTestPrintSettings(t *testing.T) (*PrintSettings, map[string]string) {
+	settings, err := PrintSettingsNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.SetPrinter("Test Printer")
+	settings.SetNCopies(3)
+	settings.SetUseColor(false)
+	settings.Set("x-gotk3-comment", "round trip")
+	want := map[string]string{
+		PRINT_SETTINGS_PRINTER:   "Test Printer",
+		PRINT_SETTINGS_N_COPIES:  "3",
+		PRINT_SETTINGS_USE_COLOR: "false",
+		"x-gotk3-comment":        "round trip",
+	}
+	return settings, want
+}
+
+func TestPrintSettingsToMap(t *testing.T) {
+	settings, want := newTestPrintSettings(t)
+	if got := settings.ToMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPrintSettingsKeyFileRoundTrip(t *testing.T) {
+	settings, want := newTestPrintSettings(t)
+	keyFile, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.ToKeyFile(keyFile, "")
+	settings.ToKeyFile(keyFile, "Other Group")
+
+	loaded, err := PrintSettingsNewFromKeyFile(keyFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.ToMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	other, err := PrintSettingsNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.Set("x-gotk3-stale", "replaced")
+	if err := other.LoadKeyFile(keyFile, "Other Group"); err != nil {
+		t.Fatal(err)
+	}
+	if got := other.ToMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if _, err := PrintSettingsNewFromKeyFile(keyFile, "Missing Group"); err == nil {
+		t.Error("expected an error loading a missing group")
+	}
+}
+
+func TestPageSetupKeyFileRoundTrip(t *testing.T) {
+	setup, err := PageSetupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paper, err := PaperSizeNew(PAPER_NAME_A5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup.SetPaperSize(paper)
+	setup.SetOrientation(PAGE_ORIENTATION_LANDSCAPE)
+	setup.SetTopMargin(12, GTK_UNIT_MM)
+	setup.SetLeftMargin(7.5, GTK_UNIT_MM)
+
+	keyFile, err := glib.KeyFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup.PageSetupToKeyFile(keyFile, "")
+
+	loaded, err := PageSetupNewFromKeyFile(keyFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPageSetup(t, loaded, PAPER_NAME_A5)
+
+	other, err := PageSetupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.PageSetupLoadKeyFile(keyFile, ""); err != nil {
+		t.Fatal(err)
+	}
+	checkPageSetup(t, other, PAPER_NAME_A5)
+}
+
+// checkPageSetup checks setup against the page setup built by the round
+// trip tests.
+func checkPageSetup(t *testing.T, setup *PageSetup, paperName string) {
+	t.Helper()
+	if name := setup.GetPaperSize().GetName(); name != paperName {
+		t.Errorf("expected paper %q, got %q", paperName, name)
+	}
+	if o := setup.GetOrientation(); o != PAGE_ORIENTATION_LANDSCAPE {
+		t.Errorf("expected a landscape orientation, got %v", o)
+	}
+	if m := setup.GetTopMargin(GTK_UNIT_MM); m != 12 {
+		t.Errorf("expected a top margin of 12mm, got %v", m)
+	}
+	if m := setup.GetLeftMargin(GTK_UNIT_MM); m != 7.5 {
+		t.Errorf("expected a left margin of 7.5mm, got %v", m)
+	}
+}
+
 // TestPrintContext tests creating and manipulating PrintContext
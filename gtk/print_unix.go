@@ -0,0 +1,630 @@
+// +build !windows,!darwin
+
+package gtk
+
+// #cgo pkg-config: gtk+-unix-print-3.0
+// #include <gtk/gtk.h>
+// #include <gtk/gtkunixprint.h>
+// #include "gtk.go.h"
+// #include "print_unix.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.gtk_print_capabilities_get_type()), marshalPrintCapabilities},
+
+		// Objects/Interfaces
+		{glib.Type(C.gtk_printer_get_type()), marshalPrinter},
+		{glib.Type(C.gtk_print_job_get_type()), marshalPrintJob},
+		{glib.Type(C.gtk_print_unix_dialog_get_type()), marshalPrintUnixDialog},
+		{glib.Type(C.gtk_page_setup_unix_dialog_get_type()), marshalPageSetupUnixDialog},
+	}
+
+	glib.RegisterGValueMarshalers(tm)
+
+	WrapMap["GtkPrinter"] = wrapPrinter
+	WrapMap["GtkPrintJob"] = wrapPrintJob
+	WrapMap["GtkPrintUnixDialog"] = wrapPrintUnixDialog
+	WrapMap["GtkPageSetupUnixDialog"] = wrapPageSetupUnixDialog
+}
+
+/*
+ * Constants
+ */
+
+// PrintCapabilities is a representation of GTK's GtkPrintCapabilities.
+type PrintCapabilities int
+
+const (
+	PRINT_CAPABILITY_PAGE_SET         PrintCapabilities = C.GTK_PRINT_CAPABILITY_PAGE_SET
+	PRINT_CAPABILITY_COPIES           PrintCapabilities = C.GTK_PRINT_CAPABILITY_COPIES
+	PRINT_CAPABILITY_COLLATE          PrintCapabilities = C.GTK_PRINT_CAPABILITY_COLLATE
+	PRINT_CAPABILITY_REVERSE          PrintCapabilities = C.GTK_PRINT_CAPABILITY_REVERSE
+	PRINT_CAPABILITY_SCALE            PrintCapabilities = C.GTK_PRINT_CAPABILITY_SCALE
+	PRINT_CAPABILITY_GENERATE_PDF     PrintCapabilities = C.GTK_PRINT_CAPABILITY_GENERATE_PDF
+	PRINT_CAPABILITY_GENERATE_PS      PrintCapabilities = C.GTK_PRINT_CAPABILITY_GENERATE_PS
+	PRINT_CAPABILITY_PREVIEW          PrintCapabilities = C.GTK_PRINT_CAPABILITY_PREVIEW
+	PRINT_CAPABILITY_NUMBER_UP        PrintCapabilities = C.GTK_PRINT_CAPABILITY_NUMBER_UP
+	PRINT_CAPABILITY_NUMBER_UP_LAYOUT PrintCapabilities = C.GTK_PRINT_CAPABILITY_NUMBER_UP_LAYOUT
+)
+
+func marshalPrintCapabilities(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return PrintCapabilities(c), nil
+}
+
+/*
+ * GtkPrinter
+ */
+
+// Printer is a representation of GTK's GtkPrinter.
+type Printer struct {
+	*glib.Object
+}
+
+func (v *Printer) native() *C.GtkPrinter {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkPrinter(p)
+}
+
+func marshalPrinter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapPrinter(obj), nil
+}
+
+func wrapPrinter(obj *glib.Object) *Printer {
+	return &Printer{obj}
+}
+
+// PrinterFunc is the type of the function called by EnumeratePrinters for
+// each printer. It returns true to stop the enumeration.
+type PrinterFunc func(printer *Printer) bool
+
+var (
+	printerFuncRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]PrinterFunc
+	}{
+		next: 1,
+		m:    make(map[int]PrinterFunc),
+	}
+)
+
+// EnumeratePrinters is a wrapper around gtk_enumerate_printers(). It calls f
+// for each printer of the print backends, which are set with the
+// "gtk-print-backends" setting. If wait is true, it returns once all the
+// printers have been enumerated, otherwise f is called from the main loop as
+// printers are found.
+func EnumeratePrinters(f PrinterFunc, wait bool) {
+	printerFuncRegistry.Lock()
+	id := printerFuncRegistry.next
+	printerFuncRegistry.next++
+	printerFuncRegistry.m[id] = f
+	printerFuncRegistry.Unlock()
+
+	C._gtk_enumerate_printers(C.gpointer(uintptr(id)), gbool(wait))
+}
+
+// GetName is a wrapper around gtk_printer_get_name().
+func (v *Printer) GetName() string {
+	return goString(C.gtk_printer_get_name(v.native()))
+}
+
+// GetStateMessage is a wrapper around gtk_printer_get_state_message().
+func (v *Printer) GetStateMessage() string {
+	return goString(C.gtk_printer_get_state_message(v.native()))
+}
+
+// GetDescription is a wrapper around gtk_printer_get_description().
+func (v *Printer) GetDescription() string {
+	return goString(C.gtk_printer_get_description(v.native()))
+}
+
+// GetLocation is a wrapper around gtk_printer_get_location().
+func (v *Printer) GetLocation() string {
+	return goString(C.gtk_printer_get_location(v.native()))
+}
+
+// GetIconName is a wrapper around gtk_printer_get_icon_name().
+func (v *Printer) GetIconName() string {
+	return goString(C.gtk_printer_get_icon_name(v.native()))
+}
+
+// GetJobCount is a wrapper around gtk_printer_get_job_count().
+func (v *Printer) GetJobCount() int {
+	return int(C.gtk_printer_get_job_count(v.native()))
+}
+
+// IsActive is a wrapper around gtk_printer_is_active().
+func (v *Printer) IsActive() bool {
+	return gobool(C.gtk_printer_is_active(v.native()))
+}
+
+// IsPaused is a wrapper around gtk_printer_is_paused().
+func (v *Printer) IsPaused() bool {
+	return gobool(C.gtk_printer_is_paused(v.native()))
+}
+
+// IsAcceptingJobs is a wrapper around gtk_printer_is_accepting_jobs().
+func (v *Printer) IsAcceptingJobs() bool {
+	return gobool(C.gtk_printer_is_accepting_jobs(v.native()))
+}
+
+// IsVirtual is a wrapper around gtk_printer_is_virtual(). Virtual printers,
+// such as the one of the file backend, do not represent actual devices.
+func (v *Printer) IsVirtual() bool {
+	return gobool(C.gtk_printer_is_virtual(v.native()))
+}
+
+// IsDefault is a wrapper around gtk_printer_is_default().
+func (v *Printer) IsDefault() bool {
+	return gobool(C.gtk_printer_is_default(v.native()))
+}
+
+// AcceptsPDF is a wrapper around gtk_printer_accepts_pdf().
+func (v *Printer) AcceptsPDF() bool {
+	return gobool(C.gtk_printer_accepts_pdf(v.native()))
+}
+
+// AcceptsPS is a wrapper around gtk_printer_accepts_ps().
+func (v *Printer) AcceptsPS() bool {
+	return gobool(C.gtk_printer_accepts_ps(v.native()))
+}
+
+// GetCapabilities is a wrapper around gtk_printer_get_capabilities().
+func (v *Printer) GetCapabilities() PrintCapabilities {
+	return PrintCapabilities(C.gtk_printer_get_capabilities(v.native()))
+}
+
+// HasDetails is a wrapper around gtk_printer_has_details(). The papers,
+// margins and default page size of a printer are only known once its
+// details have been acquired.
+func (v *Printer) HasDetails() bool {
+	return gobool(C.gtk_printer_has_details(v.native()))
+}
+
+// RequestDetails is a wrapper around gtk_printer_request_details(). The
+// "details-acquired" signal is emitted once they are known.
+func (v *Printer) RequestDetails() {
+	C.gtk_printer_request_details(v.native())
+}
+
+// ConnectDetailsAcquired connects f to the "details-acquired" signal,
+// emitted once the details requested by RequestDetails are known, or could
+// not be acquired.
+func (v *Printer) ConnectDetailsAcquired(f func(success bool)) (glib.SignalHandle, error) {
+	return v.Connect("details-acquired", func(_ interface{}, success bool) {
+		f(success)
+	})
+}
+
+// ListPapers is a wrapper around gtk_printer_list_papers(). It returns the
+// page setup of each paper size the printer supports.
+func (v *Printer) ListPapers() []*PageSetup {
+	clist := C.gtk_printer_list_papers(v.native())
+	defer C.g_list_free(clist)
+
+	var papers []*PageSetup
+	for l := clist; l != nil; l = l.next {
+		obj := glib.Take(unsafe.Pointer(l.data))
+		// The list holds a reference to each page setup.
+		C.g_object_unref(C.gpointer(l.data))
+		papers = append(papers, wrapPageSetup(obj))
+	}
+	return papers
+}
+
+// GetDefaultPageSize is a wrapper around
+// gtk_printer_get_default_page_size().
+func (v *Printer) GetDefaultPageSize() (*PageSetup, error) {
+	c := C.gtk_printer_get_default_page_size(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPageSetup(obj), nil
+}
+
+// GetHardMargins is a wrapper around gtk_printer_get_hard_margins(). It
+// returns the top, bottom, left and right margins in points, and whether
+// they are known.
+func (v *Printer) GetHardMargins() (top, bottom, left, right float64, ok bool) {
+	var ctop, cbottom, cleft, cright C.gdouble
+	c := C.gtk_printer_get_hard_margins(v.native(), &ctop, &cbottom, &cleft, &cright)
+	return float64(ctop), float64(cbottom), float64(cleft), float64(cright), gobool(c)
+}
+
+// Compare is a wrapper around gtk_printer_compare().
+func (v *Printer) Compare(other *Printer) int {
+	return int(C.gtk_printer_compare(v.native(), other.native()))
+}
+
+/*
+ * GtkPrintJob
+ */
+
+// PrintJob is a representation of GTK's GtkPrintJob.
+type PrintJob struct {
+	*glib.Object
+}
+
+func (v *PrintJob) native() *C.GtkPrintJob {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkPrintJob(p)
+}
+
+func marshalPrintJob(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapPrintJob(obj), nil
+}
+
+func wrapPrintJob(obj *glib.Object) *PrintJob {
+	return &PrintJob{obj}
+}
+
+// PrintJobNew is a wrapper around gtk_print_job_new().
+func PrintJobNew(title string, printer *Printer, settings *PrintSettings, pageSetup *PageSetup) (*PrintJob, error) {
+	cstr := C.CString(title)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gtk_print_job_new((*C.gchar)(cstr), printer.native(), settings.native(), pageSetup.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPrintJob(obj), nil
+}
+
+// GetSettings is a wrapper around gtk_print_job_get_settings().
+func (v *PrintJob) GetSettings() (*PrintSettings, error) {
+	c := C.gtk_print_job_get_settings(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapPrintSettings(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetPrinter is a wrapper around gtk_print_job_get_printer().
+func (v *PrintJob) GetPrinter() (*Printer, error) {
+	c := C.gtk_print_job_get_printer(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapPrinter(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetTitle is a wrapper around gtk_print_job_get_title().
+func (v *PrintJob) GetTitle() string {
+	return goString(C.gtk_print_job_get_title(v.native()))
+}
+
+// GetStatus is a wrapper around gtk_print_job_get_status().
+func (v *PrintJob) GetStatus() PrintStatus {
+	return PrintStatus(C.gtk_print_job_get_status(v.native()))
+}
+
+// SetSourceFile is a wrapper around gtk_print_job_set_source_file(). The
+// file must be in a format the printer accepts, such as PDF if AcceptsPDF
+// returns true.
+func (v *PrintJob) SetSourceFile(filename string) error {
+	cstr := C.CString(filename)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError = nil
+	if C.gtk_print_job_set_source_file(v.native(), (*C.gchar)(cstr), &err) == 0 {
+		return glib.TakeError(unsafe.Pointer(err))
+	}
+	return nil
+}
+
+// GetSurface is a wrapper around gtk_print_job_get_surface(). It returns the
+// surface to draw the pages of the job on, as an alternative to
+// SetSourceFile.
+func (v *PrintJob) GetSurface() (*cairo.Surface, error) {
+	var err *C.GError = nil
+	c := C.gtk_print_job_get_surface(v.native(), &err)
+	if c == nil {
+		return nil, glib.TakeError(unsafe.Pointer(err))
+	}
+	return cairo.NewSurface(uintptr(unsafe.Pointer(c)), true), nil
+}
+
+// SetTrackPrintStatus is a wrapper around
+// gtk_print_job_set_track_print_status(). When set, the "status-changed"
+// signal is emitted until the job is printed, not only until it is sent.
+func (v *PrintJob) SetTrackPrintStatus(trackStatus bool) {
+	C.gtk_print_job_set_track_print_status(v.native(), gbool(trackStatus))
+}
+
+// GetTrackPrintStatus is a wrapper around
+// gtk_print_job_get_track_print_status().
+func (v *PrintJob) GetTrackPrintStatus() bool {
+	return gobool(C.gtk_print_job_get_track_print_status(v.native()))
+}
+
+// ConnectStatusChanged connects f to the "status-changed" signal, emitted
+// when the status of the job changes. The new status is given by GetStatus.
+func (v *PrintJob) ConnectStatusChanged(f func()) (glib.SignalHandle, error) {
+	return v.Connect("status-changed", func(_ interface{}) {
+		f()
+	})
+}
+
+// PrintJobCompleteFunc is the type of the function called once a job sent
+// with PrintJob.Send is complete. err is nil if it succeeded.
+type PrintJobCompleteFunc func(job *PrintJob, err error)
+
+var (
+	printJobCompleteRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]PrintJobCompleteFunc
+	}{
+		next: 1,
+		m:    make(map[int]PrintJobCompleteFunc),
+	}
+)
+
+// Send is a wrapper around gtk_print_job_send(). f is called from the main
+// loop once the job has been sent to the printer.
+func (v *PrintJob) Send(f PrintJobCompleteFunc) {
+	printJobCompleteRegistry.Lock()
+	id := printJobCompleteRegistry.next
+	printJobCompleteRegistry.next++
+	printJobCompleteRegistry.m[id] = f
+	printJobCompleteRegistry.Unlock()
+
+	C._gtk_print_job_send(v.native(), C.gpointer(uintptr(id)))
+}
+
+/*
+ * GtkPrintUnixDialog
+ */
+
+// PrintUnixDialog is a representation of GTK's GtkPrintUnixDialog.
+type PrintUnixDialog struct {
+	Dialog
+}
+
+func (v *PrintUnixDialog) native() *C.GtkPrintUnixDialog {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkPrintUnixDialog(p)
+}
+
+func marshalPrintUnixDialog(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapPrintUnixDialog(obj), nil
+}
+
+func wrapPrintUnixDialog(obj *glib.Object) *PrintUnixDialog {
+	return &PrintUnixDialog{*wrapDialog(obj)}
+}
+
+// PrintUnixDialogNew is a wrapper around gtk_print_unix_dialog_new(). parent
+// may be nil.
+func PrintUnixDialogNew(title string, parent IWindow) (*PrintUnixDialog, error) {
+	cstr := C.CString(title)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var w *C.GtkWindow = nil
+	if parent != nil {
+		w = parent.toWindow()
+	}
+	c := C.gtk_print_unix_dialog_new((*C.gchar)(cstr), w)
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapPrintUnixDialog(obj), nil
+}
+
+// SetPageSetup is a wrapper around gtk_print_unix_dialog_set_page_setup().
+func (v *PrintUnixDialog) SetPageSetup(pageSetup *PageSetup) {
+	C.gtk_print_unix_dialog_set_page_setup(v.native(), pageSetup.native())
+}
+
+// GetPageSetup is a wrapper around gtk_print_unix_dialog_get_page_setup().
+func (v *PrintUnixDialog) GetPageSetup() (*PageSetup, error) {
+	c := C.gtk_print_unix_dialog_get_page_setup(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapPageSetup(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetPageSetupSet is a wrapper around
+// gtk_print_unix_dialog_get_page_setup_set(). It tells whether the user
+// changed the page setup in the dialog.
+func (v *PrintUnixDialog) GetPageSetupSet() bool {
+	return gobool(C.gtk_print_unix_dialog_get_page_setup_set(v.native()))
+}
+
+// SetCurrentPage is a wrapper around gtk_print_unix_dialog_set_current_page().
+func (v *PrintUnixDialog) SetCurrentPage(currentPage int) {
+	C.gtk_print_unix_dialog_set_current_page(v.native(), C.gint(currentPage))
+}
+
+// GetCurrentPage is a wrapper around gtk_print_unix_dialog_get_current_page().
+func (v *PrintUnixDialog) GetCurrentPage() int {
+	return int(C.gtk_print_unix_dialog_get_current_page(v.native()))
+}
+
+// SetSettings is a wrapper around gtk_print_unix_dialog_set_settings().
+func (v *PrintUnixDialog) SetSettings(settings *PrintSettings) {
+	C.gtk_print_unix_dialog_set_settings(v.native(), settings.native())
+}
+
+// GetSettings is a wrapper around gtk_print_unix_dialog_get_settings(). It
+// returns a copy of the settings chosen in the dialog.
+func (v *PrintUnixDialog) GetSettings() (*PrintSettings, error) {
+	c := C.gtk_print_unix_dialog_get_settings(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	C.g_object_unref(C.gpointer(c))
+	return wrapPrintSettings(obj), nil
+}
+
+// GetSelectedPrinter is a wrapper around
+// gtk_print_unix_dialog_get_selected_printer().
+func (v *PrintUnixDialog) GetSelectedPrinter() (*Printer, error) {
+	c := C.gtk_print_unix_dialog_get_selected_printer(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapPrinter(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// AddCustomTab is a wrapper around gtk_print_unix_dialog_add_custom_tab().
+// It adds child as a page of the dialog, titled with tabLabel.
+func (v *PrintUnixDialog) AddCustomTab(child, tabLabel IWidget) {
+	C.gtk_print_unix_dialog_add_custom_tab(v.native(), child.toWidget(), tabLabel.toWidget())
+}
+
+// SetManualCapabilities is a wrapper around
+// gtk_print_unix_dialog_set_manual_capabilities(). It tells which features
+// the application handles itself, the others being handled by GTK.
+func (v *PrintUnixDialog) SetManualCapabilities(capabilities PrintCapabilities) {
+	C.gtk_print_unix_dialog_set_manual_capabilities(v.native(), C.GtkPrintCapabilities(capabilities))
+}
+
+// GetManualCapabilities is a wrapper around
+// gtk_print_unix_dialog_get_manual_capabilities().
+func (v *PrintUnixDialog) GetManualCapabilities() PrintCapabilities {
+	return PrintCapabilities(C.gtk_print_unix_dialog_get_manual_capabilities(v.native()))
+}
+
+// SetSupportSelection is a wrapper around
+// gtk_print_unix_dialog_set_support_selection().
+func (v *PrintUnixDialog) SetSupportSelection(supportSelection bool) {
+	C.gtk_print_unix_dialog_set_support_selection(v.native(), gbool(supportSelection))
+}
+
+// GetSupportSelection is a wrapper around
+// gtk_print_unix_dialog_get_support_selection().
+func (v *PrintUnixDialog) GetSupportSelection() bool {
+	return gobool(C.gtk_print_unix_dialog_get_support_selection(v.native()))
+}
+
+// SetHasSelection is a wrapper around
+// gtk_print_unix_dialog_set_has_selection().
+func (v *PrintUnixDialog) SetHasSelection(hasSelection bool) {
+	C.gtk_print_unix_dialog_set_has_selection(v.native(), gbool(hasSelection))
+}
+
+// GetHasSelection is a wrapper around
+// gtk_print_unix_dialog_get_has_selection().
+func (v *PrintUnixDialog) GetHasSelection() bool {
+	return gobool(C.gtk_print_unix_dialog_get_has_selection(v.native()))
+}
+
+// SetEmbedPageSetup is a wrapper around
+// gtk_print_unix_dialog_set_embed_page_setup().
+func (v *PrintUnixDialog) SetEmbedPageSetup(embed bool) {
+	C.gtk_print_unix_dialog_set_embed_page_setup(v.native(), gbool(embed))
+}
+
+// GetEmbedPageSetup is a wrapper around
+// gtk_print_unix_dialog_get_embed_page_setup().
+func (v *PrintUnixDialog) GetEmbedPageSetup() bool {
+	return gobool(C.gtk_print_unix_dialog_get_embed_page_setup(v.native()))
+}
+
+/*
+ * GtkPageSetupUnixDialog
+ */
+
+// PageSetupUnixDialog is a representation of GTK's GtkPageSetupUnixDialog.
+type PageSetupUnixDialog struct {
+	Dialog
+}
+
+func (v *PageSetupUnixDialog) native() *C.GtkPageSetupUnixDialog {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkPageSetupUnixDialog(p)
+}
+
+func marshalPageSetupUnixDialog(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapPageSetupUnixDialog(obj), nil
+}
+
+func wrapPageSetupUnixDialog(obj *glib.Object) *PageSetupUnixDialog {
+	return &PageSetupUnixDialog{*wrapDialog(obj)}
+}
+
+// PageSetupUnixDialogNew is a wrapper around
+// gtk_page_setup_unix_dialog_new(). parent may be nil.
+func PageSetupUnixDialogNew(title string, parent IWindow) (*PageSetupUnixDialog, error) {
+	cstr := C.CString(title)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var w *C.GtkWindow = nil
+	if parent != nil {
+		w = parent.toWindow()
+	}
+	c := C.gtk_page_setup_unix_dialog_new((*C.gchar)(cstr), w)
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapPageSetupUnixDialog(obj), nil
+}
+
+// SetPageSetup is a wrapper around
+// gtk_page_setup_unix_dialog_set_page_setup().
+func (v *PageSetupUnixDialog) SetPageSetup(pageSetup *PageSetup) {
+	C.gtk_page_setup_unix_dialog_set_page_setup(v.native(), pageSetup.native())
+}
+
+// GetPageSetup is a wrapper around
+// gtk_page_setup_unix_dialog_get_page_setup().
+func (v *PageSetupUnixDialog) GetPageSetup() (*PageSetup, error) {
+	c := C.gtk_page_setup_unix_dialog_get_page_setup(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapPageSetup(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetPrintSettings is a wrapper around
+// gtk_page_setup_unix_dialog_set_print_settings().
+func (v *PageSetupUnixDialog) SetPrintSettings(settings *PrintSettings) {
+	C.gtk_page_setup_unix_dialog_set_print_settings(v.native(), settings.native())
+}
+
+// GetPrintSettings is a wrapper around
+// gtk_page_setup_unix_dialog_get_print_settings().
+func (v *PageSetupUnixDialog) GetPrintSettings() (*PrintSettings, error) {
+	c := C.gtk_page_setup_unix_dialog_get_print_settings(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return wrapPrintSettings(glib.Take(unsafe.Pointer(c))), nil
+}
@@ -0,0 +1,45 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gtk/gtk.h>
+#include <gtk/gtkunixprint.h>
+
+static GtkPrinter *
+toGtkPrinter(void *p)
+{
+	return (GTK_PRINTER(p));
+}
+
+static GtkPrintJob *
+toGtkPrintJob(void *p)
+{
+	return (GTK_PRINT_JOB(p));
+}
+
+static GtkPrintUnixDialog *
+toGtkPrintUnixDialog(void *p)
+{
+	return (GTK_PRINT_UNIX_DIALOG(p));
+}
+
+static GtkPageSetupUnixDialog *
+toGtkPageSetupUnixDialog(void *p)
+{
+	return (GTK_PAGE_SETUP_UNIX_DIALOG(p));
+}
+
+extern gboolean goPrinterFunc (GtkPrinter *printer, gpointer data);
+extern void goPrinterFuncDestroy (gpointer data);
+
+static inline void _gtk_enumerate_printers(gpointer data, gboolean wait) {
+	gtk_enumerate_printers((GtkPrinterFunc)(goPrinterFunc), data, (GDestroyNotify)(goPrinterFuncDestroy), wait);
+}
+
+extern void goPrintJobComplete (GtkPrintJob *job, gpointer data, GError *error);
+extern void goPrintJobCompleteDestroy (gpointer data);
+
+static inline void _gtk_print_job_send(GtkPrintJob *job, gpointer data) {
+	gtk_print_job_send(job, (GtkPrintJobCompleteFunc)(goPrintJobComplete), data,
+		(GDestroyNotify)(goPrintJobCompleteDestroy));
+}
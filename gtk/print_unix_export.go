@@ -0,0 +1,53 @@
+// +build !windows,!darwin
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include <gtk/gtkunixprint.h>
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+//export goPrinterFunc
+func goPrinterFunc(printer *C.GtkPrinter, data C.gpointer) C.gboolean {
+	id := int(uintptr(data))
+
+	printerFuncRegistry.RLock()
+	fn := printerFuncRegistry.m[id]
+	printerFuncRegistry.RUnlock()
+
+	return gbool(fn(wrapPrinter(glib.Take(unsafe.Pointer(printer)))))
+}
+
+//export goPrinterFuncDestroy
+func goPrinterFuncDestroy(data C.gpointer) {
+	printerFuncRegistry.Lock()
+	delete(printerFuncRegistry.m, int(uintptr(data)))
+	printerFuncRegistry.Unlock()
+}
+
+//export goPrintJobComplete
+func goPrintJobComplete(job *C.GtkPrintJob, data C.gpointer, cerr *C.GError) {
+	id := int(uintptr(data))
+
+	printJobCompleteRegistry.RLock()
+	fn := printJobCompleteRegistry.m[id]
+	printJobCompleteRegistry.RUnlock()
+
+	// The error belongs to GTK.
+	var err error
+	if cerr != nil {
+		err = glib.TakeError(unsafe.Pointer(C.g_error_copy(cerr)))
+	}
+	fn(wrapPrintJob(glib.Take(unsafe.Pointer(job))), err)
+}
+
+//export goPrintJobCompleteDestroy
+func goPrintJobCompleteDestroy(data C.gpointer) {
+	printJobCompleteRegistry.Lock()
+	delete(printJobCompleteRegistry.m, int(uintptr(data)))
+	printJobCompleteRegistry.Unlock()
+}
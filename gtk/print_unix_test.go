@@ -0,0 +1,210 @@
+// +build !windows,!darwin
+
+package gtk_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// filePrinter returns the "Print to File" printer of the file print backend,
+// skipping the test if GTK was installed without it.
+func filePrinter(t *testing.T) *gtk.Printer {
+	t.Helper()
+	settings, err := gtk.SettingsGetDefault()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := settings.SetProperty("gtk-print-backends", "file"); err != nil {
+		t.Fatal(err)
+	}
+
+	var printer *gtk.Printer
+	gtk.EnumeratePrinters(func(p *gtk.Printer) bool {
+		if p.IsVirtual() {
+			printer = p
+			return true
+		}
+		return false
+	}, true)
+	if printer == nil {
+		t.Skip("the file print backend is not available")
+	}
+	return printer
+}
+
+func TestEnumeratePrinters(t *testing.T) {
+	printer := filePrinter(t)
+	if printer.GetName() == "" {
+		t.Error("expected the printer to have a name")
+	}
+	if !printer.IsActive() || printer.IsPaused() {
+		t.Error("expected the file printer to be ready")
+	}
+	if printer.GetCapabilities()&gtk.PRINT_CAPABILITY_GENERATE_PDF == 0 {
+		t.Error("expected the file printer to generate PDF")
+	}
+	if printer.Compare(printer) != 0 {
+		t.Error("expected the printer to compare equal to itself")
+	}
+	// The file printer has no list of papers of its own.
+	for _, setup := range printer.ListPapers() {
+		if setup.GetPaperWidth(gtk.GTK_UNIT_POINTS) <= 0 {
+			t.Error("expected papers to have a width")
+		}
+	}
+}
+
+func TestPrintJobSendSurface(t *testing.T) {
+	printer := filePrinter(t)
+
+	dir, err := ioutil.TempDir("", "gotk3-print-job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "job.pdf")
+
+	settings, err := gtk.PrintSettingsNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.Set(gtk.PRINT_SETTINGS_OUTPUT_URI, "file://"+filename)
+	settings.Set(gtk.PRINT_SETTINGS_OUTPUT_FILE_FORMAT, "pdf")
+	setup, err := gtk.PageSetupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := gtk.PrintJobNew("invoice", printer, settings, setup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.GetTitle() != "invoice" {
+		t.Errorf("unexpected title %q", job.GetTitle())
+	}
+	if p, err := job.GetPrinter(); err != nil || p.Native() != printer.Native() {
+		t.Error("expected the job to be sent to the file printer")
+	}
+
+	surface, err := job.GetSurface()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := cairo.Create(surface)
+	for page := 0; page < 2; page++ {
+		cr.Rectangle(72, 72, 144, 72)
+		cr.Fill()
+		cr.ShowPage()
+	}
+
+	var statuses []gtk.PrintStatus
+	job.ConnectStatusChanged(func() {
+		statuses = append(statuses, job.GetStatus())
+	})
+	done := false
+	job.Send(func(j *gtk.PrintJob, err error) {
+		if err != nil {
+			t.Error(err)
+		}
+		done = true
+	})
+	for deadline := time.Now().Add(5 * time.Second); !done && time.Now().Before(deadline); {
+		gtk.MainIterationDo(false)
+	}
+	if !done {
+		t.Fatal("the job was not completed")
+	}
+	if len(statuses) == 0 || job.GetStatus() != gtk.PRINT_STATUS_FINISHED {
+		t.Errorf("expected the job to finish, got the statuses %v", statuses)
+	}
+
+	pdf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Error("expected the job to write a PDF file")
+	}
+}
+
+func TestPrintUnixDialog(t *testing.T) {
+	dialog, err := gtk.PrintUnixDialogNew("Print invoice", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dialog.Destroy()
+
+	page, err := gtk.LabelNew("Invoice options")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tab, err := gtk.LabelNew("Invoice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialog.AddCustomTab(page, tab)
+	if parent, _ := page.GetParent(); parent == nil {
+		t.Error("expected the custom tab to be added to the dialog")
+	}
+
+	dialog.SetCurrentPage(2)
+	if dialog.GetCurrentPage() != 2 {
+		t.Errorf("expected the current page 2, got %d", dialog.GetCurrentPage())
+	}
+	capabilities := gtk.PRINT_CAPABILITY_PAGE_SET | gtk.PRINT_CAPABILITY_COPIES
+	dialog.SetManualCapabilities(capabilities)
+	if dialog.GetManualCapabilities() != capabilities {
+		t.Error("expected the manual capabilities to be set")
+	}
+	dialog.SetSupportSelection(true)
+	dialog.SetHasSelection(true)
+	if !dialog.GetSupportSelection() || !dialog.GetHasSelection() {
+		t.Error("expected the selection to be supported")
+	}
+	dialog.SetEmbedPageSetup(true)
+	if !dialog.GetEmbedPageSetup() {
+		t.Error("expected the page setup to be embedded")
+	}
+	if _, err := dialog.GetSettings(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPageSetupUnixDialog(t *testing.T) {
+	dialog, err := gtk.PageSetupUnixDialogNew("Page setup", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dialog.Destroy()
+
+	setup, err := gtk.PageSetupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup.SetOrientation(gtk.PAGE_ORIENTATION_LANDSCAPE)
+	dialog.SetPageSetup(setup)
+	got, err := dialog.GetPageSetup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetOrientation() != gtk.PAGE_ORIENTATION_LANDSCAPE {
+		t.Error("expected the dialog to keep the landscape orientation")
+	}
+
+	settings, err := gtk.PrintSettingsNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialog.SetPrintSettings(settings)
+	if s, err := dialog.GetPrintSettings(); err != nil || s.Native() != settings.Native() {
+		t.Error("expected the dialog to use the print settings")
+	}
+}
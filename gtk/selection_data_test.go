@@ -0,0 +1,81 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestSelectionData(t *testing.T) {
+	clipboard, err := gtk.ClipboardGet(gdk.SELECTION_CLIPBOARD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clipboard.SetText("hello selection")
+
+	utf8 := gdk.GdkAtomIntern("UTF8_STRING", false)
+	data, err := clipboard.WaitForContents(utf8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := data.GetTarget().Name(); name != "UTF8_STRING" {
+		t.Errorf("expected target UTF8_STRING, got %s", name)
+	}
+	if format := data.GetFormat(); format != 8 {
+		t.Errorf("expected format 8, got %d", format)
+	}
+	if text, ok := data.GetText(); !ok || text != "hello selection" {
+		t.Errorf("expected %q, got %q (%v)", "hello selection", text, ok)
+	}
+	if data.GetURIs() != nil {
+		t.Error("expected no URIs in a text selection")
+	}
+
+	targets, err := clipboard.WaitForContents(gdk.GdkAtomIntern("TARGETS", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	atoms, ok := targets.GetTargets()
+	if !ok {
+		t.Fatal("expected a list of targets")
+	}
+	var found bool
+	for _, atom := range atoms {
+		found = found || atom == utf8
+	}
+	if !found {
+		t.Error("expected UTF8_STRING in the targets")
+	}
+	if !targets.TargetsIncludeText() {
+		t.Error("expected the targets to include text")
+	}
+	if targets.TargetsIncludeURI() {
+		t.Error("expected the targets not to include URIs")
+	}
+
+	cp, err := data.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := gdk.GdkAtomIntern("application/x-custom", false)
+	cp.Set(custom, 8, []byte{1, 2, 3})
+	if cp.GetDataType() != custom {
+		t.Errorf("expected data type %s, got %s", custom.Name(), cp.GetDataType().Name())
+	}
+	if b := cp.GetData(); len(b) != 3 || b[0] != 1 || b[2] != 3 {
+		t.Errorf("unexpected data %v", b)
+	}
+
+	if !cp.SetText("replaced") {
+		t.Error("expected SetText to succeed for a text target")
+	}
+	if text, _ := cp.GetText(); text != "replaced" {
+		t.Errorf("expected %q, got %q", "replaced", text)
+	}
+	if cp.SetURIs([]string{"file:///tmp/a"}) {
+		t.Error("expected SetURIs to fail for a text target")
+	}
+}
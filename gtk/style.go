@@ -160,6 +160,18 @@ func (v *StyleContext) HasClass(className string) bool {
 	return gobool(C.gtk_style_context_has_class(v.native(), (*C.gchar)(cstr)))
 }
 
+// ListClasses is a wrapper around gtk_style_context_list_classes().
+func (v *StyleContext) ListClasses() []string {
+	clist := C.gtk_style_context_list_classes(v.native())
+	defer C.g_list_free(clist)
+
+	var classes []string
+	for l := clist; l != nil; l = l.next {
+		classes = append(classes, C.GoString((*C.char)(l.data)))
+	}
+	return classes
+}
+
 // SetScreen is a wrapper around gtk_style_context_set_screen().
 func (v *StyleContext) SetScreen(s *gdk.Screen) {
 	C.gtk_style_context_set_screen(v.native(), (*C.GdkScreen)(unsafe.Pointer(s.Native())))
@@ -229,4 +241,3 @@ func RemoveProviderForScreen(s *gdk.Screen, provider IStyleProvider) {
 // void 	gtk_style_context_set_frame_clock ()
 // void 	gtk_style_context_set_scale ()
 // gint 	gtk_style_context_get_scale ()
-// GList * 	gtk_style_context_list_classes ()
@@ -0,0 +1,183 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_tree_drag_source_get_type()), marshalTreeDragSource},
+		{glib.Type(C.gtk_tree_drag_dest_get_type()), marshalTreeDragDest},
+	}
+
+	glib.RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GtkTreeDragSource
+ */
+
+// TreeDragSource is a representation of GTK's GtkTreeDragSource GInterface,
+// implemented by ListStore, TreeStore, TreeModelFilter and TreeModelSort.
+type TreeDragSource struct {
+	*glib.Object
+}
+
+// ITreeDragSource is an interface type implemented by all structs
+// embedding a TreeDragSource.  It is meant to be used as an argument type
+// for wrapper functions that wrap around a C GTK function taking a
+// GtkTreeDragSource.
+type ITreeDragSource interface {
+	toTreeDragSource() *C.GtkTreeDragSource
+}
+
+// native returns a pointer to the underlying GtkTreeDragSource.
+func (v *TreeDragSource) native() *C.GtkTreeDragSource {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkTreeDragSource(p)
+}
+
+func (v *TreeDragSource) toTreeDragSource() *C.GtkTreeDragSource {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+func marshalTreeDragSource(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapTreeDragSource(obj), nil
+}
+
+func wrapTreeDragSource(obj *glib.Object) *TreeDragSource {
+	return &TreeDragSource{obj}
+}
+
+// RowDraggable is a wrapper around gtk_tree_drag_source_row_draggable().
+func (v *TreeDragSource) RowDraggable(path *TreePath) bool {
+	return gobool(C.gtk_tree_drag_source_row_draggable(v.native(), path.native()))
+}
+
+// DragDataDelete is a wrapper around gtk_tree_drag_source_drag_data_delete().
+func (v *TreeDragSource) DragDataDelete(path *TreePath) bool {
+	return gobool(C.gtk_tree_drag_source_drag_data_delete(v.native(), path.native()))
+}
+
+// DragDataGet is a wrapper around gtk_tree_drag_source_drag_data_get().
+func (v *TreeDragSource) DragDataGet(path *TreePath, selectionData *SelectionData) bool {
+	return gobool(C.gtk_tree_drag_source_drag_data_get(v.native(), path.native(),
+		selectionData.native()))
+}
+
+/*
+ * GtkTreeDragDest
+ */
+
+// TreeDragDest is a representation of GTK's GtkTreeDragDest GInterface,
+// implemented by ListStore and TreeStore.
+type TreeDragDest struct {
+	*glib.Object
+}
+
+// ITreeDragDest is an interface type implemented by all structs
+// embedding a TreeDragDest.  It is meant to be used as an argument type
+// for wrapper functions that wrap around a C GTK function taking a
+// GtkTreeDragDest.
+type ITreeDragDest interface {
+	toTreeDragDest() *C.GtkTreeDragDest
+}
+
+// native returns a pointer to the underlying GtkTreeDragDest.
+func (v *TreeDragDest) native() *C.GtkTreeDragDest {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkTreeDragDest(p)
+}
+
+func (v *TreeDragDest) toTreeDragDest() *C.GtkTreeDragDest {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+func marshalTreeDragDest(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapTreeDragDest(obj), nil
+}
+
+func wrapTreeDragDest(obj *glib.Object) *TreeDragDest {
+	return &TreeDragDest{obj}
+}
+
+// DragDataReceived is a wrapper around gtk_tree_drag_dest_drag_data_received().
+func (v *TreeDragDest) DragDataReceived(dest *TreePath, selectionData *SelectionData) bool {
+	return gobool(C.gtk_tree_drag_dest_drag_data_received(v.native(), dest.native(),
+		selectionData.native()))
+}
+
+// RowDropPossible is a wrapper around gtk_tree_drag_dest_row_drop_possible().
+func (v *TreeDragDest) RowDropPossible(destPath *TreePath, selectionData *SelectionData) bool {
+	return gobool(C.gtk_tree_drag_dest_row_drop_possible(v.native(), destPath.native(),
+		selectionData.native()))
+}
+
+/*
+ * Tree row drag data
+ */
+
+// TreeSetRowDragData is a wrapper around gtk_tree_set_row_drag_data(). It
+// returns false if the target of selectionData is not
+// "GTK_TREE_MODEL_ROW".
+func TreeSetRowDragData(selectionData *SelectionData, model ITreeModel, path *TreePath) bool {
+	return gobool(C.gtk_tree_set_row_drag_data(selectionData.native(),
+		model.toTreeModel(), path.native()))
+}
+
+// TreeGetRowDragData is a wrapper around gtk_tree_get_row_drag_data(). It
+// returns the model and path of the dragged row, and false if
+// selectionData does not hold a row of a tree model.
+func TreeGetRowDragData(selectionData *SelectionData) (ITreeModel, *TreePath, bool) {
+	var (
+		cmodel *C.GtkTreeModel
+		cpath  *C.GtkTreePath
+	)
+	if !gobool(C.gtk_tree_get_row_drag_data(selectionData.native(), &cmodel, &cpath)) {
+		return nil, nil, false
+	}
+
+	path := &TreePath{cpath}
+	runtime.SetFinalizer(path, (*TreePath).free)
+	if cmodel == nil {
+		return nil, path, true
+	}
+	model, err := castTreeModel(cmodel)
+	if err != nil {
+		return nil, path, true
+	}
+	return model, path, true
+}
+
+// targetEntriesNative returns a pointer to the first of targets, or nil if
+// there are none, and the number of targets.
+func targetEntriesNative(targets []TargetEntry) (*C.GtkTargetEntry, C.gint) {
+	if len(targets) == 0 {
+		return nil, 0
+	}
+	return (*C.GtkTargetEntry)(&targets[0]), C.gint(len(targets))
+}
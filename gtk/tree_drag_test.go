@@ -0,0 +1,112 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func createDragTestStore(t *testing.T) *gtk.ListStore {
+	store, err := gtk.ListStoreNew(glib.TYPE_STRING)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"a", "b", "c"} {
+		if err := store.SetValue(store.Append(), 0, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func createRowTargets(t *testing.T) []gtk.TargetEntry {
+	target, err := gtk.TargetEntryNew("GTK_TREE_MODEL_ROW", gtk.TARGET_SAME_APP, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []gtk.TargetEntry{*target}
+}
+
+func TestTreeDragSource(t *testing.T) {
+	store := createDragTestStore(t)
+	path, err := gtk.TreePathNewFromString("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !store.RowDraggable(path) {
+		t.Error("expected list store rows to be draggable")
+	}
+	if !store.DragDataDelete(path) {
+		t.Error("expected the row to be deleted")
+	}
+	if n := store.IterNChildren(nil); n != 2 {
+		t.Errorf("expected 2 rows after deleting one, got %d", n)
+	}
+}
+
+func TestTreeViewDragDestRow(t *testing.T) {
+	store := createDragTestStore(t)
+	tv, err := gtk.TreeViewNewWithModel(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tv.EnableModelDragSource(gdk.BUTTON1_MASK, createRowTargets(t), gdk.ACTION_MOVE)
+	tv.EnableModelDragDest(createRowTargets(t), gdk.ACTION_MOVE)
+
+	if path, _ := tv.GetDragDestRow(); path != nil {
+		t.Errorf("expected no drag destination row, got %s", path)
+	}
+	path, err := gtk.TreePathNewFromString("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tv.SetDragDestRow(path, gtk.TREE_VIEW_DROP_INTO_OR_AFTER)
+	dest, pos := tv.GetDragDestRow()
+	if dest == nil || dest.String() != "2" {
+		t.Errorf("expected drag destination row 2, got %v", dest)
+	}
+	if pos != gtk.TREE_VIEW_DROP_INTO_OR_AFTER {
+		t.Errorf("expected drop position %v, got %v", gtk.TREE_VIEW_DROP_INTO_OR_AFTER, pos)
+	}
+
+	tv.SetDragDestRow(nil, gtk.TREE_VIEW_DROP_BEFORE)
+	if path, _ := tv.GetDragDestRow(); path != nil {
+		t.Errorf("expected the drag destination row to be unset, got %s", path)
+	}
+
+	tv.UnsetRowsDragSource()
+	tv.UnsetRowsDragDest()
+}
+
+func TestIconViewDragDestItem(t *testing.T) {
+	store := createDragTestStore(t)
+	iv, err := gtk.IconViewNewWithModel(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iv.SetTextColumn(0)
+
+	iv.EnableModelDragSource(gdk.BUTTON1_MASK, createRowTargets(t), gdk.ACTION_MOVE)
+	iv.EnableModelDragDest(createRowTargets(t), gdk.ACTION_MOVE)
+
+	path, err := gtk.TreePathNewFromString("0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	iv.SetDragDestItem(path, gtk.ICON_VIEW_DROP_RIGHT)
+	dest, pos := iv.GetDragDestItem()
+	if dest == nil || dest.String() != "0" {
+		t.Errorf("expected drag destination item 0, got %v", dest)
+	}
+	if pos != gtk.ICON_VIEW_DROP_RIGHT {
+		t.Errorf("expected drop position %v, got %v", gtk.ICON_VIEW_DROP_RIGHT, pos)
+	}
+
+	iv.UnsetModelDragSource()
+	iv.UnsetModelDragDest()
+}
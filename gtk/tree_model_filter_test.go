@@ -0,0 +1,229 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+type fruit struct {
+	name  string
+	price int
+}
+
+// fruitStore returns a store of unsorted fruits with their price.
+func fruitStore(t *testing.T) *gtk.ListStore {
+	t.Helper()
+	store, err := gtk.ListStoreNew(glib.TYPE_STRING, glib.TYPE_INT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []fruit{{"pear", 3}, {"apple", 5}, {"kiwi", 1}, {"fig", 8}} {
+		if err := store.Set(store.Append(), []int{0, 1}, []interface{}{f.name, f.price}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func modelString(t *testing.T, model gtk.ITreeModel, iter *gtk.TreeIter, column int) string {
+	t.Helper()
+	v, err := model.ToTreeModel().GetValue(iter, column)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := v.GetString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func modelInt(t *testing.T, model gtk.ITreeModel, iter *gtk.TreeIter, column int) int {
+	t.Helper()
+	v, err := model.ToTreeModel().GetValue(iter, column)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gv, err := v.GoValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gv.(int)
+}
+
+// cheapFruits returns a filter over sorted, hiding the fruits costing more
+// than *max.
+func cheapFruits(t *testing.T, sorted *gtk.TreeModelSort, max *int) *gtk.TreeModelFilter {
+	t.Helper()
+	filter, err := sorted.FilterNew(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.SetVisibleFunc(func(model *gtk.TreeModelFilter, iter *gtk.TreeIter, userData ...interface{}) bool {
+		child, err := model.GetModel()
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		return modelInt(t, child, iter, 1) <= *max
+	})
+	return filter
+}
+
+func TestTreeModelFilterOverSort(t *testing.T) {
+	store := fruitStore(t)
+	sorted, err := gtk.TreeModelSortNew(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sorted.SetSortColumnId(0, gtk.SORT_ASCENDING)
+	max := 5
+	filter := cheapFruits(t, sorted, &max)
+
+	var names []string
+	for iter, ok := filter.GetIterFirst(); ok; ok = filter.IterNext(iter) {
+		names = append(names, modelString(t, filter, iter, 0))
+	}
+	if fmt.Sprint(names) != "[apple kiwi pear]" {
+		t.Fatalf("expected the cheap fruits in order, got %v", names)
+	}
+
+	// "apple" is the first row of the filter, the first of the sort model
+	// and the second of the store.
+	path, _ := gtk.TreePathNewFromString("0")
+	sortPath := filter.ConvertPathToChildPath(path)
+	if sortPath == nil || sortPath.String() != "0" {
+		t.Errorf("expected the sort path 0, got %v", sortPath)
+	}
+	if storePath := sorted.ConvertPathToChildPath(sortPath); storePath == nil || storePath.String() != "1" {
+		t.Errorf("expected the store path 1, got %v", storePath)
+	}
+
+	iter, err := filter.GetIter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortIter := filter.ConvertIterToChildIter(iter)
+	if back, ok := filter.ConvertChildIterToIter(sortIter); !ok || modelString(t, filter, back, 0) != "apple" {
+		t.Error("expected the sort iter to convert back to the filter row")
+	}
+	storeIter := sorted.ConvertIterToChildIter(sortIter)
+	if name := modelString(t, store, storeIter, 0); name != "apple" {
+		t.Errorf("expected apple in the store, got %s", name)
+	}
+
+	// "fig" is in the store and the sort model, but filtered out.
+	figIter, err := store.GetIterFromString("3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	figSortIter, ok := sorted.ConvertChildIterToIter(figIter)
+	if !ok {
+		t.Fatal("expected fig in the sort model")
+	}
+	if _, ok := filter.ConvertChildIterToIter(figSortIter); ok {
+		t.Error("expected fig to be filtered out")
+	}
+
+	max = 10
+	filter.Refilter()
+	if n := filter.IterNChildren(nil); n != 4 {
+		t.Errorf("expected 4 fruits after refiltering, got %d", n)
+	}
+}
+
+func TestTreeModelBaseIter(t *testing.T) {
+	store := fruitStore(t)
+	sorted, err := gtk.TreeModelSortNew(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sorted.SetSortColumnId(1, gtk.SORT_DESCENDING)
+	max := 5
+	filter := cheapFruits(t, sorted, &max)
+
+	view, err := gtk.TreeViewNewWithModel(filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selection, err := view.GetSelection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Prices 5, 3 and 1 are shown, "pear" is the second row.
+	path, _ := gtk.TreePathNewFromString("1")
+	selection.SelectPath(path)
+
+	model, iter, ok := selection.GetSelected()
+	if !ok {
+		t.Fatal("expected a selected row")
+	}
+	base, baseIter, err := gtk.TreeModelBaseIter(model, iter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := base.(*gtk.ListStore); !ok {
+		t.Fatalf("expected the store at the base, got %T", base)
+	}
+	if name := modelString(t, base, baseIter, 0); name != "pear" {
+		t.Errorf("expected pear, got %s", name)
+	}
+	store.SetValue(baseIter, 1, 2)
+
+	base, basePath, err := gtk.TreeModelBasePath(filter, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := base.(*gtk.ListStore); !ok || basePath.String() != "0" {
+		t.Errorf("expected the first row of the store, got %s of %T", basePath, base)
+	}
+
+	// The store itself is its own base.
+	if base, _, err := gtk.TreeModelBaseIter(store, baseIter); err != nil || base.ToTreeModel().Native() != store.Native() {
+		t.Error("expected the store to be its own base")
+	}
+}
+
+func TestTreeModelFilterModifyFunc(t *testing.T) {
+	store := fruitStore(t)
+	filter, err := store.FilterNew(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.SetModifyFunc([]glib.Type{glib.TYPE_STRING, glib.TYPE_INT64}, func(model *gtk.TreeModelFilter, iter *gtk.TreeIter, column int) interface{} {
+		childIter := model.ConvertIterToChildIter(iter)
+		name := modelString(t, store, childIter, 0)
+		price := modelInt(t, store, childIter, 1)
+		switch column {
+		case 0:
+			return fmt.Sprintf("%s: %d", name, price)
+		case 1:
+			// Converted to the int64 column.
+			return price * 100
+		}
+		return nil
+	})
+
+	if n := filter.GetNColumns(); n != 2 {
+		t.Fatalf("expected the 2 columns of the modify func, got %d", n)
+	}
+	iter, err := filter.GetIterFromString("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label := modelString(t, filter, iter, 0); label != "apple: 5" {
+		t.Errorf("expected a computed label, got %q", label)
+	}
+	v, err := filter.GetValue(iter, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cents, err := v.GoValue(); err != nil || cents != int64(500) {
+		t.Errorf("expected 500 cents, got %v (%v)", cents, err)
+	}
+}
@@ -0,0 +1,214 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "tree_model_impl.go.h"
+import "C"
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	WrapMap["GotkTreeModel"] = wrapGoTreeModel
+}
+
+/*
+ * Go-implemented GtkTreeModel
+ */
+
+// TreeModelImplementation provides the rows of a GoTreeModel. Its methods
+// mirror the virtual functions of GtkTreeModelIface: methods taking an iter
+// to fill in store the identity of a row in the iter with SetUserData,
+// SetUserData2 and SetUserData3, and read it back from the iters passed to
+// the other methods. A nil parent iter stands for the root of the model.
+//
+// The user data of an iter is an integer handle, such as a row index or a
+// key into a Go map; Go pointers must not be stored in it. Iters are stamped
+// by the GoTreeModel, implementations need not care about stamps.
+type TreeModelImplementation interface {
+	GetFlags() TreeModelFlags
+	GetNColumns() int
+	GetColumnType(column int) glib.Type
+	GetIter(iter *TreeIter, path *TreePath) bool
+	GetPath(iter *TreeIter) *TreePath
+	// GetValue returns the value of column for the row of iter. It may
+	// return a *glib.Value, a GObject wrapper or any Go value accepted by
+	// glib.GValue that can be converted to the column type; nil leaves the
+	// default value of the column type.
+	GetValue(iter *TreeIter, column int) interface{}
+	IterNext(iter *TreeIter) bool
+	IterChildren(iter, parent *TreeIter) bool
+	IterHasChild(iter *TreeIter) bool
+	IterNChildren(iter *TreeIter) int
+	IterNthChild(iter, parent *TreeIter, n int) bool
+	IterParent(iter, child *TreeIter) bool
+}
+
+// TreeModelIterPreviousImplementation may be implemented by a
+// TreeModelImplementation to provide a faster iter_previous than the
+// default one, which goes through GetPath and GetIter.
+type TreeModelIterPreviousImplementation interface {
+	IterPrevious(iter *TreeIter) bool
+}
+
+// TreeModelRefNodeImplementation may be implemented by a
+// TreeModelImplementation to be told which rows are displayed, e.g. to
+// cache them, see gtk_tree_model_ref_node().
+type TreeModelRefNodeImplementation interface {
+	RefNode(iter *TreeIter)
+	UnrefNode(iter *TreeIter)
+}
+
+var (
+	goTreeModelRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]TreeModelImplementation
+	}{
+		next: 1,
+		m:    make(map[int]TreeModelImplementation),
+	}
+)
+
+// GoTreeModel is a GtkTreeModel implemented in Go. It can be displayed by a
+// TreeView, ComboBox or any other widget taking an ITreeModel without
+// copying the rows into a ListStore or TreeStore. After changing the data
+// behind the implementation, notify views with RowInserted, RowChanged,
+// RowDeleted, RowHasChildToggled or RowsReordered.
+type GoTreeModel struct {
+	TreeModel
+}
+
+func wrapGoTreeModel(obj *glib.Object) *GoTreeModel {
+	return &GoTreeModel{TreeModel{obj}}
+}
+
+// GoTreeModelNew creates a GoTreeModel serving the rows of impl.
+func GoTreeModelNew(impl TreeModelImplementation) (*GoTreeModel, error) {
+	if impl == nil {
+		return nil, errors.New("impl is nil")
+	}
+
+	goTreeModelRegistry.Lock()
+	id := goTreeModelRegistry.next
+	goTreeModelRegistry.next++
+	goTreeModelRegistry.m[id] = impl
+	goTreeModelRegistry.Unlock()
+
+	c := C._gotk_tree_model_new(C.guint(id))
+	if c == nil {
+		goTreeModelRegistry.Lock()
+		delete(goTreeModelRegistry.m, id)
+		goTreeModelRegistry.Unlock()
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	// Take added its own reference, drop the one from g_object_new so the
+	// model, and impl, are released once unused.
+	C.g_object_unref(C.gpointer(c))
+	return wrapGoTreeModel(obj), nil
+}
+
+// InvalidateIters makes all iters previously handed out by the model
+// invalid. Models without TREE_MODEL_ITERS_PERSIST should call it whenever
+// rows are inserted, deleted or reordered.
+func (v *GoTreeModel) InvalidateIters() {
+	C._gotk_tree_model_invalidate_iters(v.toGObject())
+}
+
+// IterIsValid reports whether iter was handed out by the model since the
+// last call to InvalidateIters.
+func (v *GoTreeModel) IterIsValid(iter *TreeIter) bool {
+	if iter == nil || iter.native().stamp == 0 {
+		return false
+	}
+	return iter.native().stamp == C._gotk_tree_model_get_stamp(v.toGObject())
+}
+
+// toGObject returns a pointer to the underlying GObject.
+func (v *GoTreeModel) toGObject() *C.GObject {
+	return C.toGObject(unsafe.Pointer(v.Native()))
+}
+
+// goTreeModelImplementation returns the implementation registered as id.
+func goTreeModelImplementation(id C.guint) TreeModelImplementation {
+	goTreeModelRegistry.RLock()
+	defer goTreeModelRegistry.RUnlock()
+	return goTreeModelRegistry.m[int(id)]
+}
+
+// wrapTreeIterPtr wraps an iter owned by GTK, returning nil for a NULL
+// pointer.
+func wrapTreeIterPtr(iter *C.GtkTreeIter) *TreeIter {
+	return (*TreeIter)(unsafe.Pointer(iter))
+}
+
+// setTreeModelValue initializes value to the type of column and sets it
+// from v.
+func setTreeModelValue(value *C.GValue, columnType glib.Type, v interface{}) {
+	ctype := C.GType(columnType)
+	switch e := v.(type) {
+	case nil:
+		C._gotk_value_init_from(value, ctype, nil)
+		return
+	case *glib.Value:
+		C._gotk_value_init_from(value, ctype, (*C.GValue)(unsafe.Pointer(e.Native())))
+		return
+	case glib.IObject:
+		if columnType.IsA(glib.TYPE_OBJECT) || columnType.IsA(glib.TYPE_INTERFACE) {
+			if n, ok := v.(interface{ Native() uintptr }); ok {
+				C._gotk_value_init_object(value, ctype, C.gpointer(unsafe.Pointer(n.Native())))
+				return
+			}
+		}
+	}
+
+	gv, err := glib.GValue(v)
+	if err != nil {
+		C._gotk_value_init_from(value, ctype, nil)
+		return
+	}
+	C._gotk_value_init_from(value, ctype, (*C.GValue)(unsafe.Pointer(gv.Native())))
+}
+
+/*
+ * GtkTreeIter user data
+ */
+
+// UserData returns the user_data field of the iter, set by the model that
+// handed it out.
+func (v *TreeIter) UserData() uintptr {
+	return uintptr(v.native().user_data)
+}
+
+// SetUserData sets the user_data field of the iter. It is meant to be
+// used by TreeModelImplementations.
+func (v *TreeIter) SetUserData(data uintptr) {
+	v.native().user_data = C.gpointer(data)
+}
+
+// UserData2 returns the user_data2 field of the iter.
+func (v *TreeIter) UserData2() uintptr {
+	return uintptr(v.native().user_data2)
+}
+
+// SetUserData2 sets the user_data2 field of the iter.
+func (v *TreeIter) SetUserData2(data uintptr) {
+	v.native().user_data2 = C.gpointer(data)
+}
+
+// UserData3 returns the user_data3 field of the iter.
+func (v *TreeIter) UserData3() uintptr {
+	return uintptr(v.native().user_data3)
+}
+
+// SetUserData3 sets the user_data3 field of the iter.
+func (v *TreeIter) SetUserData3(data uintptr) {
+	v.native().user_data3 = C.gpointer(data)
+}
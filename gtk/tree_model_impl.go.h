@@ -0,0 +1,193 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gtk/gtk.h>
+
+/*
+ * GotkTreeModel is a GtkTreeModel whose rows are provided by Go. The id is
+ * the handle of the Go implementation in goTreeModelRegistry. Iters handed
+ * out by the model carry its stamp, which is renewed to invalidate them.
+ */
+
+extern GtkTreeModelFlags goTreeModelGetFlags (guint id);
+extern gint goTreeModelGetNColumns (guint id);
+extern GType goTreeModelGetColumnType (guint id, gint index);
+extern gboolean goTreeModelGetIter (guint id, GtkTreeIter *iter, GtkTreePath *path);
+extern GtkTreePath *goTreeModelGetPath (guint id, GtkTreeIter *iter);
+extern void goTreeModelGetValue (guint id, GtkTreeIter *iter, gint column, GValue *value);
+extern gboolean goTreeModelIterNext (guint id, GtkTreeIter *iter);
+extern gboolean goTreeModelIterPrevious (guint id, GtkTreeIter *iter);
+extern gboolean goTreeModelIterChildren (guint id, GtkTreeIter *iter, GtkTreeIter *parent);
+extern gboolean goTreeModelIterHasChild (guint id, GtkTreeIter *iter);
+extern gint goTreeModelIterNChildren (guint id, GtkTreeIter *iter);
+extern gboolean goTreeModelIterNthChild (guint id, GtkTreeIter *iter, GtkTreeIter *parent, gint n);
+extern gboolean goTreeModelIterParent (guint id, GtkTreeIter *iter, GtkTreeIter *child);
+extern void goTreeModelRefNode (guint id, GtkTreeIter *iter);
+extern void goTreeModelUnrefNode (guint id, GtkTreeIter *iter);
+extern void goTreeModelFinalize (guint id);
+
+typedef struct {
+	GObject parent_instance;
+	guint id;
+	gint stamp;
+} GotkTreeModel;
+
+typedef struct {
+	GObjectClass parent_class;
+} GotkTreeModelClass;
+
+static void gotk_tree_model_iface_init (GtkTreeModelIface *iface);
+
+G_DEFINE_TYPE_WITH_CODE (GotkTreeModel, gotk_tree_model, G_TYPE_OBJECT,
+	G_IMPLEMENT_INTERFACE (GTK_TYPE_TREE_MODEL, gotk_tree_model_iface_init))
+
+#define GOTK_TREE_MODEL(m) ((GotkTreeModel *)(m))
+
+static gboolean gotk_tree_model_stamp_iter (GtkTreeModel *model, GtkTreeIter *iter, gboolean ok) {
+	if (iter != NULL)
+		iter->stamp = ok ? GOTK_TREE_MODEL(model)->stamp : 0;
+	return ok;
+}
+
+static GtkTreeModelFlags gotk_tree_model_get_flags (GtkTreeModel *model) {
+	return goTreeModelGetFlags(GOTK_TREE_MODEL(model)->id);
+}
+
+static gint gotk_tree_model_get_n_columns (GtkTreeModel *model) {
+	return goTreeModelGetNColumns(GOTK_TREE_MODEL(model)->id);
+}
+
+static GType gotk_tree_model_get_column_type (GtkTreeModel *model, gint index) {
+	return goTreeModelGetColumnType(GOTK_TREE_MODEL(model)->id, index);
+}
+
+static gboolean gotk_tree_model_get_iter (GtkTreeModel *model, GtkTreeIter *iter, GtkTreePath *path) {
+	return gotk_tree_model_stamp_iter(model, iter,
+		goTreeModelGetIter(GOTK_TREE_MODEL(model)->id, iter, path));
+}
+
+static GtkTreePath *gotk_tree_model_get_path (GtkTreeModel *model, GtkTreeIter *iter) {
+	g_return_val_if_fail(iter->stamp == GOTK_TREE_MODEL(model)->stamp, NULL);
+	return goTreeModelGetPath(GOTK_TREE_MODEL(model)->id, iter);
+}
+
+static void gotk_tree_model_get_value (GtkTreeModel *model, GtkTreeIter *iter, gint column, GValue *value) {
+	g_return_if_fail(iter->stamp == GOTK_TREE_MODEL(model)->stamp);
+	goTreeModelGetValue(GOTK_TREE_MODEL(model)->id, iter, column, value);
+}
+
+static gboolean gotk_tree_model_iter_next (GtkTreeModel *model, GtkTreeIter *iter) {
+	g_return_val_if_fail(iter->stamp == GOTK_TREE_MODEL(model)->stamp, FALSE);
+	return gotk_tree_model_stamp_iter(model, iter,
+		goTreeModelIterNext(GOTK_TREE_MODEL(model)->id, iter));
+}
+
+static gboolean gotk_tree_model_iter_previous (GtkTreeModel *model, GtkTreeIter *iter) {
+	g_return_val_if_fail(iter->stamp == GOTK_TREE_MODEL(model)->stamp, FALSE);
+	return gotk_tree_model_stamp_iter(model, iter,
+		goTreeModelIterPrevious(GOTK_TREE_MODEL(model)->id, iter));
+}
+
+static gboolean gotk_tree_model_iter_children (GtkTreeModel *model, GtkTreeIter *iter, GtkTreeIter *parent) {
+	return gotk_tree_model_stamp_iter(model, iter,
+		goTreeModelIterChildren(GOTK_TREE_MODEL(model)->id, iter, parent));
+}
+
+static gboolean gotk_tree_model_iter_has_child (GtkTreeModel *model, GtkTreeIter *iter) {
+	return goTreeModelIterHasChild(GOTK_TREE_MODEL(model)->id, iter);
+}
+
+static gint gotk_tree_model_iter_n_children (GtkTreeModel *model, GtkTreeIter *iter) {
+	return goTreeModelIterNChildren(GOTK_TREE_MODEL(model)->id, iter);
+}
+
+static gboolean gotk_tree_model_iter_nth_child (GtkTreeModel *model, GtkTreeIter *iter, GtkTreeIter *parent, gint n) {
+	return gotk_tree_model_stamp_iter(model, iter,
+		goTreeModelIterNthChild(GOTK_TREE_MODEL(model)->id, iter, parent, n));
+}
+
+static gboolean gotk_tree_model_iter_parent (GtkTreeModel *model, GtkTreeIter *iter, GtkTreeIter *child) {
+	return gotk_tree_model_stamp_iter(model, iter,
+		goTreeModelIterParent(GOTK_TREE_MODEL(model)->id, iter, child));
+}
+
+static void gotk_tree_model_ref_node (GtkTreeModel *model, GtkTreeIter *iter) {
+	goTreeModelRefNode(GOTK_TREE_MODEL(model)->id, iter);
+}
+
+static void gotk_tree_model_unref_node (GtkTreeModel *model, GtkTreeIter *iter) {
+	goTreeModelUnrefNode(GOTK_TREE_MODEL(model)->id, iter);
+}
+
+static void gotk_tree_model_iface_init (GtkTreeModelIface *iface) {
+	iface->get_flags = gotk_tree_model_get_flags;
+	iface->get_n_columns = gotk_tree_model_get_n_columns;
+	iface->get_column_type = gotk_tree_model_get_column_type;
+	iface->get_iter = gotk_tree_model_get_iter;
+	iface->get_path = gotk_tree_model_get_path;
+	iface->get_value = gotk_tree_model_get_value;
+	iface->iter_next = gotk_tree_model_iter_next;
+	iface->iter_previous = gotk_tree_model_iter_previous;
+	iface->iter_children = gotk_tree_model_iter_children;
+	iface->iter_has_child = gotk_tree_model_iter_has_child;
+	iface->iter_n_children = gotk_tree_model_iter_n_children;
+	iface->iter_nth_child = gotk_tree_model_iter_nth_child;
+	iface->iter_parent = gotk_tree_model_iter_parent;
+	iface->ref_node = gotk_tree_model_ref_node;
+	iface->unref_node = gotk_tree_model_unref_node;
+}
+
+static void gotk_tree_model_finalize (GObject *object) {
+	goTreeModelFinalize(GOTK_TREE_MODEL(object)->id);
+	G_OBJECT_CLASS(gotk_tree_model_parent_class)->finalize(object);
+}
+
+static void gotk_tree_model_class_init (GotkTreeModelClass *klass) {
+	G_OBJECT_CLASS(klass)->finalize = gotk_tree_model_finalize;
+}
+
+static void gotk_tree_model_init (GotkTreeModel *self) {
+	/* 0 is the stamp of invalid iters. */
+	do {
+		self->stamp = g_random_int();
+	} while (self->stamp == 0);
+}
+
+static inline GObject *_gotk_tree_model_new (guint id) {
+	GotkTreeModel *model = g_object_new(gotk_tree_model_get_type(), NULL);
+	model->id = id;
+	return G_OBJECT(model);
+}
+
+static inline void _gotk_tree_model_invalidate_iters (GObject *model) {
+	do {
+		GOTK_TREE_MODEL(model)->stamp++;
+	} while (GOTK_TREE_MODEL(model)->stamp == 0);
+}
+
+static inline gint _gotk_tree_model_get_stamp (GObject *model) {
+	return GOTK_TREE_MODEL(model)->stamp;
+}
+
+/*
+ * _gotk_value_init_from initializes dest to type and fills it from src,
+ * which may hold any type transformable to type, or be NULL to leave the
+ * default value of type.
+ */
+static inline void _gotk_value_init_from (GValue *dest, GType type, const GValue *src) {
+	g_value_init(dest, type);
+	if (src == NULL)
+		return;
+	if (!g_value_type_transformable(G_VALUE_TYPE(src), type)) {
+		g_warning("cannot convert a value of type %s to column type %s",
+			G_VALUE_TYPE_NAME(src), g_type_name(type));
+		return;
+	}
+	g_value_transform(src, dest);
+}
+
+static inline void _gotk_value_init_object (GValue *dest, GType type, gpointer object) {
+	g_value_init(dest, type);
+	g_value_set_object(dest, object);
+}
@@ -0,0 +1,196 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// rangeModel is a flat, virtual model of n rows whose first column holds
+// the row index times two and whose second column holds "row <index>".
+type rangeModel struct {
+	n int
+}
+
+func (m *rangeModel) GetFlags() gtk.TreeModelFlags {
+	return gtk.TREE_MODEL_LIST_ONLY | gtk.TREE_MODEL_ITERS_PERSIST
+}
+
+func (m *rangeModel) GetNColumns() int {
+	return 2
+}
+
+func (m *rangeModel) GetColumnType(column int) glib.Type {
+	if column == 0 {
+		return glib.TYPE_INT64
+	}
+	return glib.TYPE_STRING
+}
+
+func (m *rangeModel) GetIter(iter *gtk.TreeIter, path *gtk.TreePath) bool {
+	indices := path.GetIndices()
+	if len(indices) != 1 || indices[0] >= m.n {
+		return false
+	}
+	iter.SetUserData(uintptr(indices[0]))
+	return true
+}
+
+func (m *rangeModel) GetPath(iter *gtk.TreeIter) *gtk.TreePath {
+	path, err := gtk.TreePathNew()
+	if err != nil {
+		return nil
+	}
+	path.AppendIndex(int(iter.UserData()))
+	return path
+}
+
+func (m *rangeModel) GetValue(iter *gtk.TreeIter, column int) interface{} {
+	row := int(iter.UserData())
+	if column == 0 {
+		return row * 2
+	}
+	return fmt.Sprintf("row %d", row)
+}
+
+func (m *rangeModel) IterNext(iter *gtk.TreeIter) bool {
+	row := int(iter.UserData()) + 1
+	if row >= m.n {
+		return false
+	}
+	iter.SetUserData(uintptr(row))
+	return true
+}
+
+func (m *rangeModel) IterChildren(iter, parent *gtk.TreeIter) bool {
+	return m.IterNthChild(iter, parent, 0)
+}
+
+func (m *rangeModel) IterHasChild(iter *gtk.TreeIter) bool {
+	return false
+}
+
+func (m *rangeModel) IterNChildren(iter *gtk.TreeIter) int {
+	if iter != nil {
+		return 0
+	}
+	return m.n
+}
+
+func (m *rangeModel) IterNthChild(iter, parent *gtk.TreeIter, n int) bool {
+	if parent != nil || n >= m.n {
+		return false
+	}
+	iter.SetUserData(uintptr(n))
+	return true
+}
+
+func (m *rangeModel) IterParent(iter, child *gtk.TreeIter) bool {
+	return false
+}
+
+func TestGoTreeModel(t *testing.T) {
+	impl := &rangeModel{n: 1000000}
+	model, err := gtk.GoTreeModelNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := model.IterNChildren(nil); n != impl.n {
+		t.Errorf("expected %d rows, got %d", impl.n, n)
+	}
+	if n := model.GetNColumns(); n != 2 {
+		t.Errorf("expected 2 columns, got %d", n)
+	}
+	if f := model.GetFlags(); f&gtk.TREE_MODEL_LIST_ONLY == 0 {
+		t.Errorf("expected a list-only model, got flags %v", f)
+	}
+
+	iter, err := model.GetIterFromString("999999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !model.IterIsValid(iter) {
+		t.Error("expected the iter to be valid")
+	}
+	value, err := model.GetValue(iter, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := value.GoValue(); err != nil || v != int64(1999998) {
+		t.Errorf("expected 1999998, got %v (%v)", v, err)
+	}
+	value, err = model.GetValue(iter, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := value.GetString(); err != nil || s != "row 999999" {
+		t.Errorf("expected %q, got %q (%v)", "row 999999", s, err)
+	}
+	if model.IterNext(iter) {
+		t.Error("expected no row after the last one")
+	}
+
+	iter, ok := model.GetIterFirst()
+	if !ok {
+		t.Fatal("expected a first row")
+	}
+	if !model.IterNext(iter) {
+		t.Fatal("expected a second row")
+	}
+	path, err := model.GetPath(iter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := path.String(); s != "1" {
+		t.Errorf("expected path 1, got %s", s)
+	}
+	if !model.IterPrevious(iter) {
+		t.Error("expected a row before the second one")
+	}
+
+	model.InvalidateIters()
+	if model.IterIsValid(iter) {
+		t.Error("expected the iter to be invalidated")
+	}
+}
+
+func TestGoTreeModelTreeView(t *testing.T) {
+	impl := &rangeModel{n: 3}
+	model, err := gtk.GoTreeModelNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tv, err := gtk.TreeViewNewWithModel(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := tv.GetModel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*gtk.GoTreeModel); !ok {
+		t.Errorf("expected a *gtk.GoTreeModel, got %T", m)
+	}
+
+	var inserted string
+	model.Connect("row-inserted", func(_ interface{}, path *gtk.TreePath) {
+		inserted = path.String()
+	})
+
+	impl.n++
+	path, _ := gtk.TreePathNewFromString("3")
+	iter, err := model.GetIter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	model.RowInserted(path, iter)
+	if inserted != "3" {
+		t.Errorf("expected row-inserted for path 3, got %q", inserted)
+	}
+}
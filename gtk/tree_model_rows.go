@@ -0,0 +1,461 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <stdlib.h>
+// #include <gtk/gtk.h>
+import "C"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+/*
+ * Typed rows
+ */
+
+var (
+	pixbufPtrType = reflect.TypeOf((*gdk.Pixbuf)(nil))
+	objectPtrType = reflect.TypeOf((*glib.Object)(nil))
+)
+
+// rowField is a column of a RowType.
+type rowField struct {
+	name   string
+	index  int
+	column int
+	gtype  glib.Type
+}
+
+// RowType maps the exported fields of a Go struct to the columns of a
+// ListStore or TreeStore, in field order. A field is named after its "gtk"
+// struct tag, or after the field itself if it has none; fields tagged
+// `gtk:"-"` are skipped.
+//
+// The Go type of each field determines the type of its column: bool,
+// string, int8, uint8, int64, uint64, float32 and float64 map to the
+// GLib type of the same size, int16 and int32 to TYPE_INT, uint16 and
+// uint32 to TYPE_UINT, int and uint to TYPE_INT64 and TYPE_UINT64 so that
+// their values are never truncated, *gdk.Pixbuf to a pixbuf column and
+// *glib.Object to TYPE_OBJECT. Named types such as `type Priority int` are
+// mapped by their underlying kind.
+//
+// The rows of a store may also be read and written as values of the struct
+// type with TypedRowListStore and TypedRowTreeStore, from Go 1.18.
+//
+// A struct like
+//  type Contact struct {
+//  	Name  string `gtk:"name"`
+//  	Age   int    `gtk:"age"`
+//  	Notes string `gtk:"-"`
+//  }
+// gives a model with a TYPE_STRING and a TYPE_INT64 column.
+type RowType struct {
+	typ    reflect.Type
+	fields []rowField
+	names  map[string]int
+}
+
+// RowTypeOf returns the RowType of prototype, a struct or a pointer to a
+// struct. A non-nil error is returned if a field has a type that cannot be
+// stored in a column.
+func RowTypeOf(prototype interface{}) (*RowType, error) {
+	t := reflect.TypeOf(prototype)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.New("prototype is not a struct or a pointer to a struct")
+	}
+
+	rt := &RowType{typ: t, names: make(map[string]int)}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("gtk")
+		if tag == "-" || f.PkgPath != "" {
+			continue
+		}
+		gtype, ok := rowFieldType(f.Type)
+		if !ok {
+			return nil, fmt.Errorf("field %s of %s: unsupported type %s", f.Name, t, f.Type)
+		}
+		name := tag
+		if name == "" {
+			name = f.Name
+		}
+		if _, ok := rt.names[name]; ok {
+			return nil, fmt.Errorf("field %s of %s: duplicate column name %q", f.Name, t, name)
+		}
+		column := len(rt.fields)
+		rt.fields = append(rt.fields, rowField{name, i, column, gtype})
+		rt.names[name] = column
+		if name != f.Name {
+			if _, ok := rt.names[f.Name]; ok {
+				return nil, fmt.Errorf("field %s of %s: name taken as the column name of another field", f.Name, t)
+			}
+			rt.names[f.Name] = column
+		}
+	}
+	if len(rt.fields) == 0 {
+		return nil, fmt.Errorf("%s has no exported fields", t)
+	}
+	return rt, nil
+}
+
+// rowFieldType returns the column type of a struct field of type t.
+func rowFieldType(t reflect.Type) (glib.Type, bool) {
+	switch t {
+	case pixbufPtrType:
+		return gdk.PixbufGetType(), true
+	case objectPtrType:
+		return glib.TYPE_OBJECT, true
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return glib.TYPE_BOOLEAN, true
+	case reflect.String:
+		return glib.TYPE_STRING, true
+	case reflect.Int8:
+		return glib.TYPE_CHAR, true
+	case reflect.Int16, reflect.Int32:
+		return glib.TYPE_INT, true
+	case reflect.Int, reflect.Int64:
+		return glib.TYPE_INT64, true
+	case reflect.Uint8:
+		return glib.TYPE_UCHAR, true
+	case reflect.Uint16, reflect.Uint32:
+		return glib.TYPE_UINT, true
+	case reflect.Uint, reflect.Uint64:
+		return glib.TYPE_UINT64, true
+	case reflect.Float32:
+		return glib.TYPE_FLOAT, true
+	case reflect.Float64:
+		return glib.TYPE_DOUBLE, true
+	}
+	return glib.TYPE_INVALID, false
+}
+
+// ColumnTypes returns the types of the columns, in order.
+func (t *RowType) ColumnTypes() []glib.Type {
+	types := make([]glib.Type, len(t.fields))
+	for i, f := range t.fields {
+		types[i] = f.gtype
+	}
+	return types
+}
+
+// Column returns the index of the column of a field, looked up by its tag
+// name or by its Go field name.
+func (t *RowType) Column(name string) (int, error) {
+	column, ok := t.names[name]
+	if !ok {
+		return -1, fmt.Errorf("%s has no column %q", t.typ, name)
+	}
+	return column, nil
+}
+
+// GetRow reads the row of model at iter into row, which must be a pointer
+// to the struct type of t. The columns of model must match t.
+func (t *RowType) GetRow(model ITreeModel, iter *TreeIter, row interface{}) error {
+	rv := reflect.ValueOf(row)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Type() != t.typ {
+		return fmt.Errorf("row is a %T, not a *%s", row, t.typ)
+	}
+	rv = rv.Elem()
+
+	m := model.toTreeModel()
+	if err := t.checkModel(m); err != nil {
+		return err
+	}
+	for _, f := range t.fields {
+		var gv C.GValue
+		C.gtk_tree_model_get_value(m, iter.native(), C.gint(f.column), &gv)
+		f.get(&gv, rv.Field(f.index))
+		C.g_value_unset(&gv)
+	}
+	return nil
+}
+
+// checkModel returns a non-nil error if the columns of m do not hold the
+// fields of t.
+func (t *RowType) checkModel(m *C.GtkTreeModel) error {
+	if n := int(C.gtk_tree_model_get_n_columns(m)); n < len(t.fields) {
+		return fmt.Errorf("model has %d columns, %s needs %d", n, t.typ, len(t.fields))
+	}
+	for _, f := range t.fields {
+		ctype := C.gtk_tree_model_get_column_type(m, C.gint(f.column))
+		if !gobool(C.g_type_is_a(ctype, C.GType(f.gtype))) {
+			return fmt.Errorf("column %d of the model cannot hold field %s of %s",
+				f.column, f.name, t.typ)
+		}
+	}
+	return nil
+}
+
+// values returns the columns and initialized values of row, which must be
+// a struct of the type of t or a pointer to one. The values must be freed
+// with unsetRowValues.
+func (t *RowType) values(row interface{}) ([]C.gint, []C.GValue, error) {
+	rv := reflect.ValueOf(row)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.Type() != t.typ {
+		return nil, nil, fmt.Errorf("row is a %T, not a %s", row, t.typ)
+	}
+
+	columns := make([]C.gint, len(t.fields))
+	values := make([]C.GValue, len(t.fields))
+	for i, f := range t.fields {
+		columns[i] = C.gint(f.column)
+		C.g_value_init(&values[i], C.GType(f.gtype))
+		f.set(&values[i], rv.Field(f.index))
+	}
+	return columns, values, nil
+}
+
+func unsetRowValues(values []C.GValue) {
+	for i := range values {
+		C.g_value_unset(&values[i])
+	}
+}
+
+// set stores the field value fv in gv, initialized to the column type.
+func (f *rowField) set(gv *C.GValue, fv reflect.Value) {
+	switch fv.Kind() {
+	case reflect.Bool:
+		C.g_value_set_boolean(gv, gbool(fv.Bool()))
+	case reflect.String:
+		cstr := C.CString(fv.String())
+		C.g_value_set_string(gv, (*C.gchar)(cstr))
+		C.free(unsafe.Pointer(cstr))
+	case reflect.Int8:
+		C.g_value_set_schar(gv, C.gint8(fv.Int()))
+	case reflect.Int16, reflect.Int32:
+		C.g_value_set_int(gv, C.gint(fv.Int()))
+	case reflect.Int, reflect.Int64:
+		C.g_value_set_int64(gv, C.gint64(fv.Int()))
+	case reflect.Uint8:
+		C.g_value_set_uchar(gv, C.guchar(fv.Uint()))
+	case reflect.Uint16, reflect.Uint32:
+		C.g_value_set_uint(gv, C.guint(fv.Uint()))
+	case reflect.Uint, reflect.Uint64:
+		C.g_value_set_uint64(gv, C.guint64(fv.Uint()))
+	case reflect.Float32:
+		C.g_value_set_float(gv, C.gfloat(fv.Float()))
+	case reflect.Float64:
+		C.g_value_set_double(gv, C.gdouble(fv.Float()))
+	case reflect.Ptr:
+		if fv.IsNil() {
+			return
+		}
+		var obj *glib.Object
+		if fv.Type() == pixbufPtrType {
+			obj = fv.Interface().(*gdk.Pixbuf).Object
+		} else {
+			obj = fv.Interface().(*glib.Object)
+		}
+		C.g_value_set_object(gv, C.gpointer(unsafe.Pointer(obj.Native())))
+	}
+}
+
+// get stores the value gv, read from the column of f, in the field fv.
+func (f *rowField) get(gv *C.GValue, fv reflect.Value) {
+	switch fv.Kind() {
+	case reflect.Bool:
+		fv.SetBool(gobool(C.g_value_get_boolean(gv)))
+	case reflect.String:
+		fv.SetString(C.GoString((*C.char)(C.g_value_get_string(gv))))
+	case reflect.Int8:
+		fv.SetInt(int64(C.g_value_get_schar(gv)))
+	case reflect.Int16, reflect.Int32:
+		fv.SetInt(int64(C.g_value_get_int(gv)))
+	case reflect.Int, reflect.Int64:
+		fv.SetInt(int64(C.g_value_get_int64(gv)))
+	case reflect.Uint8:
+		fv.SetUint(uint64(C.g_value_get_uchar(gv)))
+	case reflect.Uint16, reflect.Uint32:
+		fv.SetUint(uint64(C.g_value_get_uint(gv)))
+	case reflect.Uint, reflect.Uint64:
+		fv.SetUint(uint64(C.g_value_get_uint64(gv)))
+	case reflect.Float32:
+		fv.SetFloat(float64(C.g_value_get_float(gv)))
+	case reflect.Float64:
+		fv.SetFloat(float64(C.g_value_get_double(gv)))
+	case reflect.Ptr:
+		c := C.g_value_get_object(gv)
+		if c == nil {
+			fv.Set(reflect.Zero(fv.Type()))
+			return
+		}
+		obj := glib.Take(unsafe.Pointer(c))
+		if fv.Type() == pixbufPtrType {
+			fv.Set(reflect.ValueOf(&gdk.Pixbuf{obj}))
+		} else {
+			fv.Set(reflect.ValueOf(obj))
+		}
+	}
+}
+
+/*
+ * RowListStore
+ */
+
+// RowListStore is a ListStore whose columns are the fields of a Go struct,
+// see RowType.
+type RowListStore struct {
+	*ListStore
+	rowType *RowType
+}
+
+// RowListStoreNew creates a ListStore with the columns of the RowType of
+// prototype.
+func RowListStoreNew(prototype interface{}) (*RowListStore, error) {
+	rt, err := RowTypeOf(prototype)
+	if err != nil {
+		return nil, err
+	}
+	store, err := ListStoreNew(rt.ColumnTypes()...)
+	if err != nil {
+		return nil, err
+	}
+	return &RowListStore{store, rt}, nil
+}
+
+// RowType returns the RowType of the store.
+func (v *RowListStore) RowType() *RowType {
+	return v.rowType
+}
+
+// Column returns the index of the column of a field, see RowType.Column.
+func (v *RowListStore) Column(name string) (int, error) {
+	return v.rowType.Column(name)
+}
+
+// AppendRow appends row, a struct or a pointer to a struct of the row type
+// of the store, and returns its iter.
+func (v *RowListStore) AppendRow(row interface{}) (*TreeIter, error) {
+	return v.InsertRow(-1, row)
+}
+
+// InsertRow inserts row at position, or appends it if position is -1 or
+// larger than the number of rows, and returns its iter. The row is inserted
+// with its values, as by gtk_list_store_insert_with_valuesv().
+func (v *RowListStore) InsertRow(position int, row interface{}) (*TreeIter, error) {
+	columns, values, err := v.rowType.values(row)
+	if err != nil {
+		return nil, err
+	}
+	defer unsetRowValues(values)
+
+	var ti C.GtkTreeIter
+	C.gtk_list_store_insert_with_valuesv(v.native(), &ti, C.gint(position),
+		&columns[0], &values[0], C.gint(len(values)))
+	return &TreeIter{ti}, nil
+}
+
+// SetRow replaces the values of the row at iter with row.
+func (v *RowListStore) SetRow(iter *TreeIter, row interface{}) error {
+	columns, values, err := v.rowType.values(row)
+	if err != nil {
+		return err
+	}
+	defer unsetRowValues(values)
+
+	C.gtk_list_store_set_valuesv(v.native(), iter.native(),
+		&columns[0], &values[0], C.gint(len(values)))
+	return nil
+}
+
+// GetRow reads the row at iter into row, a pointer to a struct of the row
+// type of the store.
+func (v *RowListStore) GetRow(iter *TreeIter, row interface{}) error {
+	return v.rowType.GetRow(v.ListStore, iter, row)
+}
+
+/*
+ * RowTreeStore
+ */
+
+// RowTreeStore is a TreeStore whose columns are the fields of a Go struct,
+// see RowType.
+type RowTreeStore struct {
+	*TreeStore
+	rowType *RowType
+}
+
+// RowTreeStoreNew creates a TreeStore with the columns of the RowType of
+// prototype.
+func RowTreeStoreNew(prototype interface{}) (*RowTreeStore, error) {
+	rt, err := RowTypeOf(prototype)
+	if err != nil {
+		return nil, err
+	}
+	store, err := TreeStoreNew(rt.ColumnTypes()...)
+	if err != nil {
+		return nil, err
+	}
+	return &RowTreeStore{store, rt}, nil
+}
+
+// RowType returns the RowType of the store.
+func (v *RowTreeStore) RowType() *RowType {
+	return v.rowType
+}
+
+// Column returns the index of the column of a field, see RowType.Column.
+func (v *RowTreeStore) Column(name string) (int, error) {
+	return v.rowType.Column(name)
+}
+
+// AppendRow appends row as the last child of parent, or as a toplevel row
+// if parent is nil, and returns its iter.
+func (v *RowTreeStore) AppendRow(parent *TreeIter, row interface{}) (*TreeIter, error) {
+	return v.InsertRow(parent, -1, row)
+}
+
+// InsertRow inserts row as the child of parent at position, or appends it
+// if position is -1 or larger than the number of children, and returns its
+// iter. The row is inserted with its values, as by
+// gtk_tree_store_insert_with_valuesv().
+func (v *RowTreeStore) InsertRow(parent *TreeIter, position int, row interface{}) (*TreeIter, error) {
+	columns, values, err := v.rowType.values(row)
+	if err != nil {
+		return nil, err
+	}
+	defer unsetRowValues(values)
+
+	var cparent *C.GtkTreeIter
+	if parent != nil {
+		cparent = parent.native()
+	}
+	var ti C.GtkTreeIter
+	C.gtk_tree_store_insert_with_valuesv(v.native(), &ti, cparent, C.gint(position),
+		&columns[0], &values[0], C.gint(len(values)))
+	return &TreeIter{ti}, nil
+}
+
+// SetRow replaces the values of the row at iter with row.
+func (v *RowTreeStore) SetRow(iter *TreeIter, row interface{}) error {
+	columns, values, err := v.rowType.values(row)
+	if err != nil {
+		return err
+	}
+	defer unsetRowValues(values)
+
+	C.gtk_tree_store_set_valuesv(v.native(), iter.native(),
+		&columns[0], &values[0], C.gint(len(values)))
+	return nil
+}
+
+// GetRow reads the row at iter into row, a pointer to a struct of the row
+// type of the store.
+func (v *RowTreeStore) GetRow(iter *TreeIter, row interface{}) error {
+	return v.rowType.GetRow(v.TreeStore, iter, row)
+}
@@ -0,0 +1,98 @@
+//go:build go1.18
+// +build go1.18
+
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+/*
+ * TypedRowListStore
+ */
+
+// TypedRowListStore is a RowListStore whose rows are read and written as
+// values of the struct type T:
+//
+//	store, err := gtk.TypedRowListStoreNew[Contact]()
+//	...
+//	contact, err := store.GetRow(iter)
+type TypedRowListStore[T any] struct {
+	*RowListStore
+}
+
+// TypedRowListStoreNew creates a ListStore with the columns of the RowType
+// of T, which must be a struct type.
+func TypedRowListStoreNew[T any]() (*TypedRowListStore[T], error) {
+	var prototype T
+	store, err := RowListStoreNew(prototype)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedRowListStore[T]{store}, nil
+}
+
+// AppendRow appends row and returns its iter.
+func (v *TypedRowListStore[T]) AppendRow(row T) (*TreeIter, error) {
+	return v.RowListStore.AppendRow(row)
+}
+
+// InsertRow inserts row at position, see RowListStore.InsertRow.
+func (v *TypedRowListStore[T]) InsertRow(position int, row T) (*TreeIter, error) {
+	return v.RowListStore.InsertRow(position, row)
+}
+
+// SetRow replaces the values of the row at iter with row.
+func (v *TypedRowListStore[T]) SetRow(iter *TreeIter, row T) error {
+	return v.RowListStore.SetRow(iter, row)
+}
+
+// GetRow returns the row at iter.
+func (v *TypedRowListStore[T]) GetRow(iter *TreeIter) (T, error) {
+	var row T
+	err := v.RowListStore.GetRow(iter, &row)
+	return row, err
+}
+
+/*
+ * TypedRowTreeStore
+ */
+
+// TypedRowTreeStore is a RowTreeStore whose rows are read and written as
+// values of the struct type T.
+type TypedRowTreeStore[T any] struct {
+	*RowTreeStore
+}
+
+// TypedRowTreeStoreNew creates a TreeStore with the columns of the RowType
+// of T, which must be a struct type.
+func TypedRowTreeStoreNew[T any]() (*TypedRowTreeStore[T], error) {
+	var prototype T
+	store, err := RowTreeStoreNew(prototype)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedRowTreeStore[T]{store}, nil
+}
+
+// AppendRow appends row as the last child of parent, or as a toplevel row
+// if parent is nil, and returns its iter.
+func (v *TypedRowTreeStore[T]) AppendRow(parent *TreeIter, row T) (*TreeIter, error) {
+	return v.RowTreeStore.AppendRow(parent, row)
+}
+
+// InsertRow inserts row as the child of parent at position, see
+// RowTreeStore.InsertRow.
+func (v *TypedRowTreeStore[T]) InsertRow(parent *TreeIter, position int, row T) (*TreeIter, error) {
+	return v.RowTreeStore.InsertRow(parent, position, row)
+}
+
+// SetRow replaces the values of the row at iter with row.
+func (v *TypedRowTreeStore[T]) SetRow(iter *TreeIter, row T) error {
+	return v.RowTreeStore.SetRow(iter, row)
+}
+
+// GetRow returns the row at iter.
+func (v *TypedRowTreeStore[T]) GetRow(iter *TreeIter) (T, error) {
+	var row T
+	err := v.RowTreeStore.GetRow(iter, &row)
+	return row, err
+}
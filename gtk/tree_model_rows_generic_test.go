@@ -0,0 +1,72 @@
+//go:build go1.18
+// +build go1.18
+
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestTypedRowListStore(t *testing.T) {
+	store, err := gtk.TypedRowListStoreNew[task]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iter, err := store.AppendRow(task{Title: "first", Priority: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.GetRow(iter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != (task{Title: "first", Priority: 2}) {
+		t.Errorf("unexpected row %+v", got)
+	}
+
+	got.Done = true
+	if err := store.SetRow(iter, got); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.GetRow(iter); err != nil || !got.Done {
+		t.Errorf("expected the row to be done, got %+v (%v)", got, err)
+	}
+
+	if _, err := gtk.TypedRowListStoreNew[int](); err == nil {
+		t.Error("expected an error for a non-struct row type")
+	}
+}
+
+func TestTypedRowTreeStore(t *testing.T) {
+	store, err := gtk.TypedRowTreeStoreNew[task]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent, err := store.AppendRow(nil, task{Title: "parent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := store.InsertRow(parent, 0, task{Title: "child", Estimate: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetRow(parent, task{Title: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for iter, expected := range map[*gtk.TreeIter]task{parent: {Title: "renamed"}, child: {Title: "child", Estimate: 3}} {
+		got, err := store.GetRow(iter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != expected {
+			t.Errorf("expected the row %+v, got %+v", expected, got)
+		}
+	}
+}
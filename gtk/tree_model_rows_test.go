@@ -0,0 +1,218 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+type priority uint8
+
+type task struct {
+	Title    string   `gtk:"title"`
+	Done     bool     `gtk:"done"`
+	Priority priority `gtk:"priority"`
+	Progress float64
+	Estimate int64  `gtk:"estimate"`
+	Note     string `gtk:"-"`
+	internal int
+}
+
+func TestRowTypeOf(t *testing.T) {
+	rt, err := gtk.RowTypeOf(task{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []glib.Type{glib.TYPE_STRING, glib.TYPE_BOOLEAN, glib.TYPE_UCHAR, glib.TYPE_DOUBLE, glib.TYPE_INT64}
+	types := rt.ColumnTypes()
+	if len(types) != len(expected) {
+		t.Fatalf("expected %d columns, got %d", len(expected), len(types))
+	}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Errorf("column %d: expected %s, got %s", i, expected[i].Name(), types[i].Name())
+		}
+	}
+
+	for name, expected := range map[string]int{"title": 0, "Title": 0, "priority": 2, "Progress": 3, "estimate": 4} {
+		if column, err := rt.Column(name); err != nil || column != expected {
+			t.Errorf("expected column %d for %q, got %d (%v)", expected, name, column, err)
+		}
+	}
+	if _, err := rt.Column("Note"); err == nil {
+		t.Error("expected no column for a skipped field")
+	}
+
+	if _, err := gtk.RowTypeOf(struct{ C chan int }{}); err == nil {
+		t.Error("expected an error for an unsupported field type")
+	}
+	if _, err := gtk.RowTypeOf(struct {
+		A string `gtk:"B"`
+		B string `gtk:"b"`
+	}{}); err == nil {
+		t.Error("expected an error for a field named after the column name of another field")
+	}
+	if _, err := gtk.RowTypeOf(42); err == nil {
+		t.Error("expected an error for a non-struct prototype")
+	}
+}
+
+func TestRowListStore(t *testing.T) {
+	store, err := gtk.RowListStoreNew(&task{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.AppendRow(task{Title: "first", Priority: 3, Progress: 0.5, Estimate: 1 << 40}); err != nil {
+		t.Fatal(err)
+	}
+	iter, err := store.AppendRow(&task{Title: "second", Done: true, Note: "not stored"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AppendRow(struct{ Title string }{"wrong"}); err == nil {
+		t.Error("expected an error when appending a row of another type")
+	}
+
+	var got task
+	if err := store.GetRow(iter, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != (task{Title: "second", Done: true}) {
+		t.Errorf("unexpected second row %+v", got)
+	}
+
+	first, ok := store.GetIterFirst()
+	if !ok {
+		t.Fatal("expected a first row")
+	}
+	if err := store.GetRow(first, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != (task{Title: "first", Priority: 3, Progress: 0.5, Estimate: 1 << 40}) {
+		t.Errorf("unexpected first row %+v", got)
+	}
+
+	got.Done = true
+	if err := store.SetRow(first, got); err != nil {
+		t.Fatal(err)
+	}
+	column, err := store.Column("done")
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := store.GetValue(first, column)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if done, err := value.GoValue(); err != nil || done != true {
+		t.Errorf("expected the first row to be done, got %v (%v)", done, err)
+	}
+
+	if err := store.GetRow(first, got); err == nil {
+		t.Error("expected an error when reading into a non-pointer")
+	}
+}
+
+func TestRowListStoreWideIntegers(t *testing.T) {
+	type counters struct {
+		Signed   int
+		Unsigned uint
+	}
+	rt, err := gtk.RowTypeOf(counters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if types := rt.ColumnTypes(); types[0] != glib.TYPE_INT64 || types[1] != glib.TYPE_UINT64 {
+		t.Errorf("expected int and uint to map to 64-bit columns, got %s and %s", types[0].Name(), types[1].Name())
+	}
+
+	store, err := gtk.RowListStoreNew(counters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Values beyond 32 bits, on 64-bit platforms.
+	wide := int64(1) << 32
+	row := counters{Signed: -int(wide) - 1, Unsigned: uint(wide) + 5}
+	iter, err := store.AppendRow(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got counters
+	if err := store.GetRow(iter, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != row {
+		t.Errorf("expected %+v to be stored without truncation, got %+v", row, got)
+	}
+}
+
+func TestRowTreeStore(t *testing.T) {
+	store, err := gtk.RowTreeStoreNew(task{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent, err := store.AppendRow(nil, task{Title: "parent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AppendRow(parent, task{Title: "last child"}); err != nil {
+		t.Fatal(err)
+	}
+	child, err := store.InsertRow(parent, 0, task{Title: "first child", Priority: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := store.IterNChildren(parent); n != 2 {
+		t.Errorf("expected 2 children, got %d", n)
+	}
+	path, err := store.GetPath(child)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := path.String(); s != "0:0" {
+		t.Errorf("expected path 0:0, got %s", s)
+	}
+
+	var got task
+	if err := store.GetRow(child, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "first child" || got.Priority != 1 {
+		t.Errorf("unexpected child row %+v", got)
+	}
+
+	// A RowType can read rows of any model with matching columns.
+	other, err := gtk.RowTypeOf(struct {
+		Title string
+		Done  bool
+	}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var partial struct {
+		Title string
+		Done  bool
+	}
+	if err := other.GetRow(store, parent, &partial); err != nil {
+		t.Fatal(err)
+	}
+	if partial.Title != "parent" {
+		t.Errorf("expected title %q, got %q", "parent", partial.Title)
+	}
+
+	mismatched, err := gtk.RowTypeOf(struct{ Count int }{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count struct{ Count int }
+	if err := mismatched.GetRow(store, parent, &count); err == nil {
+		t.Error("expected an error for a model with mismatching columns")
+	}
+}
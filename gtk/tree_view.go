@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"unsafe"
 
+	"github.com/gotk3/gotk3/cairo"
 	"github.com/gotk3/gotk3/gdk"
 	"github.com/gotk3/gotk3/glib"
 )
@@ -546,8 +547,80 @@ func (v *TreeView) SetTooltipRow(tooltip *Tooltip, path *TreePath) {
 	C.gtk_tree_view_set_tooltip_row(v.native(), tooltip.native(), path.native())
 }
 
+// EnableModelDragSource is a wrapper around gtk_tree_view_enable_model_drag_source().
+func (v *TreeView) EnableModelDragSource(startButtonMask gdk.ModifierType, targets []TargetEntry, actions gdk.DragAction) {
+	ctargets, n := targetEntriesNative(targets)
+	C.gtk_tree_view_enable_model_drag_source(v.native(), C.GdkModifierType(startButtonMask),
+		ctargets, n, C.GdkDragAction(actions))
+}
+
+// EnableModelDragDest is a wrapper around gtk_tree_view_enable_model_drag_dest().
+func (v *TreeView) EnableModelDragDest(targets []TargetEntry, actions gdk.DragAction) {
+	ctargets, n := targetEntriesNative(targets)
+	C.gtk_tree_view_enable_model_drag_dest(v.native(), ctargets, n, C.GdkDragAction(actions))
+}
+
+// UnsetRowsDragSource is a wrapper around gtk_tree_view_unset_rows_drag_source().
+func (v *TreeView) UnsetRowsDragSource() {
+	C.gtk_tree_view_unset_rows_drag_source(v.native())
+}
+
+// UnsetRowsDragDest is a wrapper around gtk_tree_view_unset_rows_drag_dest().
+func (v *TreeView) UnsetRowsDragDest() {
+	C.gtk_tree_view_unset_rows_drag_dest(v.native())
+}
+
+// SetDragDestRow is a wrapper around gtk_tree_view_set_drag_dest_row().
+// A nil path removes the highlight.
+func (v *TreeView) SetDragDestRow(path *TreePath, pos TreeViewDropPosition) {
+	C.gtk_tree_view_set_drag_dest_row(v.native(), path.native(), C.GtkTreeViewDropPosition(pos))
+}
+
+// GetDragDestRow is a wrapper around gtk_tree_view_get_drag_dest_row().
+// The returned path is nil if no row is highlighted.
+func (v *TreeView) GetDragDestRow() (*TreePath, TreeViewDropPosition) {
+	var (
+		cpath *C.GtkTreePath
+		pos   C.GtkTreeViewDropPosition
+	)
+	C.gtk_tree_view_get_drag_dest_row(v.native(), &cpath, &pos)
+
+	var path *TreePath
+	if cpath != nil {
+		path = &TreePath{cpath}
+		runtime.SetFinalizer(path, (*TreePath).free)
+	}
+	return path, TreeViewDropPosition(pos)
+}
+
+// GetDestRowAtPos is a wrapper around gtk_tree_view_get_dest_row_at_pos().
+// dragX and dragY are in widget coordinates. It returns false if there is
+// no row at the position.
+func (v *TreeView) GetDestRowAtPos(dragX, dragY int) (*TreePath, TreeViewDropPosition, bool) {
+	var (
+		cpath *C.GtkTreePath
+		pos   C.GtkTreeViewDropPosition
+	)
+	ok := gobool(C.gtk_tree_view_get_dest_row_at_pos(v.native(), C.gint(dragX), C.gint(dragY), &cpath, &pos))
+
+	var path *TreePath
+	if cpath != nil {
+		path = &TreePath{cpath}
+		runtime.SetFinalizer(path, (*TreePath).free)
+	}
+	return path, TreeViewDropPosition(pos), ok
+}
+
+// CreateRowDragIcon is a wrapper around gtk_tree_view_create_row_drag_icon().
+func (v *TreeView) CreateRowDragIcon(path *TreePath) (*cairo.Surface, error) {
+	c := C.gtk_tree_view_create_row_drag_icon(v.native(), path.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	return cairo.NewSurface(uintptr(unsafe.Pointer(c)), false), nil
+}
+
 // TODO:
-// GtkTreeViewDropPosition
 // gboolean 	gtk_tree_view_get_tooltip_context ()
 // void 	(*GtkTreeDestroyCountFunc) ()
 // gboolean 	(*GtkTreeViewRowSeparatorFunc) ()
@@ -567,11 +640,3 @@ func (v *TreeView) SetTooltipRow(tooltip *Tooltip, path *TreePath) {
 // void 	gtk_tree_view_convert_tree_to_bin_window_coords ()
 // void 	gtk_tree_view_convert_tree_to_widget_coords ()
 // void 	gtk_tree_view_convert_widget_to_tree_coords ()
-// void 	gtk_tree_view_enable_model_drag_dest ()
-// void 	gtk_tree_view_enable_model_drag_source ()
-// void 	gtk_tree_view_unset_rows_drag_source ()
-// void 	gtk_tree_view_unset_rows_drag_dest ()
-// void 	gtk_tree_view_set_drag_dest_row ()
-// void 	gtk_tree_view_get_drag_dest_row ()
-// gboolean 	gtk_tree_view_get_dest_row_at_pos ()
-// cairo_surface_t * 	gtk_tree_view_create_row_drag_icon ()
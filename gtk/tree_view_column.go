@@ -256,8 +256,26 @@ func (v *TreeViewColumn) GetXOffset() int {
 	return int(C.gtk_tree_view_column_get_x_offset(v.native()))
 }
 
+// TreeCellDataFunc is the function called by a TreeViewColumn to set the
+// properties of cell for the row of model at iter, instead of or in
+// addition to its attributes. See TreeViewColumn.SetCellDataFunc.
+type TreeCellDataFunc func(column *TreeViewColumn, cell *CellRenderer, model *TreeModel, iter *TreeIter)
+
+// SetCellDataFunc is a wrapper around
+// gtk_tree_view_column_set_cell_data_func(). f is kept until it is replaced,
+// or the column is destroyed. A nil f removes the current function.
+func (v *TreeViewColumn) SetCellDataFunc(cell ICellRenderer, f TreeCellDataFunc) {
+	if f == nil {
+		C.gtk_tree_view_column_set_cell_data_func(v.native(), cell.toCellRenderer(), nil, nil, nil)
+		return
+	}
+	id := registerCellDataFunc(func(obj *glib.Object, cell *CellRenderer, model *TreeModel, iter *TreeIter) {
+		f(wrapTreeViewColumn(obj), cell, model, iter)
+	})
+	C._gtk_tree_view_column_set_cell_data_func(v.native(), cell.toCellRenderer(), id)
+}
+
 // void 	gtk_tree_view_column_set_attributes ()
-// void 	gtk_tree_view_column_set_cell_data_func ()
 
 type TreeViewColumnSizing int
 
@@ -0,0 +1,127 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// renderOffscreen shows w in an offscreen window and runs the main loop
+// until done returns true, or a second has passed.
+func renderOffscreen(t *testing.T, w gtk.IWidget, done func() bool) {
+	t.Helper()
+	win, err := gtk.OffscreenWindowNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer win.Destroy()
+	win.Add(w)
+	win.ShowAll()
+
+	deadline := time.Now().Add(time.Second)
+	for !done() && time.Now().Before(deadline) {
+		gtk.MainIterationDo(false)
+	}
+}
+
+func TestTreeViewColumnSetCellDataFunc(t *testing.T) {
+	store, err := gtk.ListStoreNew(glib.TYPE_DOUBLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, price := range []float64{1.5, 20} {
+		if err := store.SetValue(store.Append(), 0, price); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	view, err := gtk.TreeViewNewWithModel(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := gtk.CellRendererTextNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := gtk.TreeViewColumnNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	column.PackStart(renderer, true)
+	view.AppendColumn(column)
+
+	formatted := make(map[string]bool)
+	column.SetCellDataFunc(renderer, func(c *gtk.TreeViewColumn, cell *gtk.CellRenderer, model *gtk.TreeModel, iter *gtk.TreeIter) {
+		if c.Native() != column.Native() {
+			t.Error("expected the column the function was set on")
+		}
+		v, err := model.GetValue(iter, 0)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		price, _ := v.GoValue()
+		text := fmt.Sprintf("$%.2f", price)
+		cell.SetProperty("text", text)
+		formatted[text] = true
+	})
+
+	renderOffscreen(t, view, func() bool { return len(formatted) == 2 })
+	if !formatted["$1.50"] || !formatted["$20.00"] {
+		t.Errorf("expected both rows to be formatted, got %v", formatted)
+	}
+
+	// Removing the function stops the calls.
+	column.SetCellDataFunc(renderer, nil)
+	formatted = make(map[string]bool)
+	view.QueueDraw()
+	renderOffscreen(t, view, func() bool { return false })
+	if len(formatted) != 0 {
+		t.Errorf("expected no calls after removing the function, got %v", formatted)
+	}
+}
+
+func TestCellLayoutSetCellDataFunc(t *testing.T) {
+	store, err := gtk.ListStoreNew(glib.TYPE_STRING, glib.TYPE_BOOLEAN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Set(store.Append(), []int{0, 1}, []interface{}{"urgent", true}); err != nil {
+		t.Fatal(err)
+	}
+
+	combo, err := gtk.ComboBoxNewWithModel(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := gtk.CellRendererTextNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	combo.PackStart(renderer, true)
+	combo.AddAttribute(renderer, "text", 0)
+
+	var colored bool
+	combo.SetCellDataFunc(renderer, func(layout *gtk.CellLayout, cell *gtk.CellRenderer, model *gtk.TreeModel, iter *gtk.TreeIter) {
+		v, err := model.GetValue(iter, 1)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if urgent, _ := v.GoValue(); urgent == true {
+			cell.SetProperty("foreground", "red")
+			colored = true
+		}
+	})
+	combo.SetActive(0)
+
+	renderOffscreen(t, combo, func() bool { return colored })
+	if !colored {
+		t.Error("expected the cell data function to be called for the active row")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"unsafe"
 
+	"github.com/gotk3/gotk3/cairo"
 	"github.com/gotk3/gotk3/gdk"
 	"github.com/gotk3/gotk3/glib"
 )
@@ -271,10 +272,28 @@ func (v *Widget) GetPreferredWidth() (int, int) {
 	return int(minimum), int(natural)
 }
 
-// TODO:
+// GetPreferredHeightForWidth is a wrapper around
 // gtk_widget_get_preferred_height_for_width().
+func (v *Widget) GetPreferredHeightForWidth(width int) (int, int) {
+	var minimum, natural C.gint
+	C.gtk_widget_get_preferred_height_for_width(v.native(), C.gint(width), &minimum, &natural)
+	return int(minimum), int(natural)
+}
+
+// GetPreferredWidthForHeight is a wrapper around
 // gtk_widget_get_preferred_width_for_height().
-// gtk_widget_get_request_mode().
+func (v *Widget) GetPreferredWidthForHeight(height int) (int, int) {
+	var minimum, natural C.gint
+	C.gtk_widget_get_preferred_width_for_height(v.native(), C.gint(height), &minimum, &natural)
+	return int(minimum), int(natural)
+}
+
+// GetRequestMode is a wrapper around gtk_widget_get_request_mode().
+func (v *Widget) GetRequestMode() SizeRequestMode {
+	return SizeRequestMode(C.gtk_widget_get_request_mode(v.native()))
+}
+
+// TODO:
 // gtk_widget_get_preferred_size().
 // gtk_distribute_natural_allocation().
 
@@ -407,10 +426,23 @@ func (v *Widget) Unmap() {
 	C.gtk_widget_unmap(v.native())
 }
 
+// Realize is a wrapper around gtk_widget_realize().
+func (v *Widget) Realize() {
+	C.gtk_widget_realize(v.native())
+}
+
+// Unrealize is a wrapper around gtk_widget_unrealize().
+func (v *Widget) Unrealize() {
+	C.gtk_widget_unrealize(v.native())
+}
+
+// Draw is a wrapper around gtk_widget_draw(). The widget must be allocated,
+// and is drawn with its top left corner at the origin of cr.
+func (v *Widget) Draw(cr *cairo.Context) {
+	C.gtk_widget_draw(v.native(), (*C.cairo_t)(unsafe.Pointer(cr.Native())))
+}
+
 // TODO:
-//void gtk_widget_realize(GtkWidget *widget);
-//void gtk_widget_unrealize(GtkWidget *widget);
-//void gtk_widget_draw(GtkWidget *widget, cairo_t *cr);
 //void gtk_widget_queue_resize(GtkWidget *widget);
 //void gtk_widget_queue_resize_no_redraw(GtkWidget *widget);
 // gtk_widget_queue_allocate().
@@ -0,0 +1,175 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk
+
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "widget_impl.go.h"
+import "C"
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	WrapMap["GotkWidget"] = wrapGoWidget
+	WrapMap["GotkContainer"] = wrapGoContainer
+}
+
+/*
+ * Go-implemented GtkWidget and GtkContainer
+ */
+
+// WidgetImplementation sizes a GoWidget or a GoContainer. Its methods, and
+// those of the optional interfaces below, mirror the virtual functions of
+// GtkWidgetClass. The widget being sized is passed to each method.
+//
+// GoWidget and GoContainer have no GdkWindow of their own: they draw on the
+// window of their parent, and their allocation and the allocations of their
+// children are in the coordinates of that window.
+type WidgetImplementation interface {
+	GetPreferredWidth(widget *Widget) (minimum, natural int)
+	GetPreferredHeight(widget *Widget) (minimum, natural int)
+}
+
+// WidgetHeightForWidthImplementation may be implemented by a
+// WidgetImplementation whose height depends on its width, like wrapping
+// text, or the other way around. GetRequestMode tells which of the two
+// methods is used by GTK.
+type WidgetHeightForWidthImplementation interface {
+	GetRequestMode(widget *Widget) SizeRequestMode
+	GetPreferredHeightForWidth(widget *Widget, width int) (minimum, natural int)
+	GetPreferredWidthForHeight(widget *Widget, height int) (minimum, natural int)
+}
+
+// WidgetSizeAllocateImplementation may be implemented by a
+// WidgetImplementation to be told of its new size and position. The
+// allocation of the widget is already set when SizeAllocate is called. A
+// GoContainer must allocate each of its visible children with
+// Widget.SizeAllocate from there.
+type WidgetSizeAllocateImplementation interface {
+	SizeAllocate(widget *Widget, allocation *Allocation)
+}
+
+// WidgetDrawImplementation may be implemented by a WidgetImplementation
+// drawing its own content. cr is set up so that the origin is the top left
+// corner of the widget. The children of a GoContainer are drawn over that
+// content unless Draw returns true.
+type WidgetDrawImplementation interface {
+	Draw(widget *Widget, cr *cairo.Context) bool
+}
+
+// ContainerImplementation keeps track of the children of a GoContainer.
+// Add and Remove are called by Container.Add and Container.Remove, the
+// child being respectively parented to the container right after Add and
+// unparented right after Remove. Forall calls callback for each child,
+// and must allow callback to remove the child it is called for, as is done
+// when the container is destroyed.
+type ContainerImplementation interface {
+	WidgetImplementation
+	Add(container *Container, child *Widget)
+	Remove(container *Container, child *Widget)
+	Forall(container *Container, includeInternals bool, callback func(child *Widget))
+}
+
+var (
+	goWidgetRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]WidgetImplementation
+	}{
+		next: 1,
+		m:    make(map[int]WidgetImplementation),
+	}
+)
+
+// GoWidget is a GtkWidget implemented in Go, drawn entirely by its
+// WidgetImplementation.
+type GoWidget struct {
+	Widget
+}
+
+func wrapGoWidget(obj *glib.Object) *GoWidget {
+	return &GoWidget{Widget{glib.InitiallyUnowned{obj}}}
+}
+
+// GoWidgetNew creates a GoWidget sized and drawn by impl.
+func GoWidgetNew(impl WidgetImplementation) (*GoWidget, error) {
+	if impl == nil {
+		return nil, errors.New("impl is nil")
+	}
+	id := registerGoWidget(impl)
+	c := C._gotk_go_widget_new(C.guint(id))
+	if c == nil {
+		unregisterGoWidget(id)
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGoWidget(obj), nil
+}
+
+// GoContainer is a GtkContainer implemented in Go, whose children are
+// kept track of, measured and placed by its ContainerImplementation.
+type GoContainer struct {
+	Container
+}
+
+func wrapGoContainer(obj *glib.Object) *GoContainer {
+	return &GoContainer{Container{Widget{glib.InitiallyUnowned{obj}}}}
+}
+
+// GoContainerNew creates a GoContainer managed by impl.
+func GoContainerNew(impl ContainerImplementation) (*GoContainer, error) {
+	if impl == nil {
+		return nil, errors.New("impl is nil")
+	}
+	id := registerGoWidget(impl)
+	c := C._gotk_go_container_new(C.guint(id))
+	if c == nil {
+		unregisterGoWidget(id)
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapGoContainer(obj), nil
+}
+
+func registerGoWidget(impl WidgetImplementation) int {
+	goWidgetRegistry.Lock()
+	defer goWidgetRegistry.Unlock()
+	id := goWidgetRegistry.next
+	goWidgetRegistry.next++
+	goWidgetRegistry.m[id] = impl
+	return id
+}
+
+func unregisterGoWidget(id int) {
+	goWidgetRegistry.Lock()
+	delete(goWidgetRegistry.m, id)
+	goWidgetRegistry.Unlock()
+}
+
+// goWidgetImplementation returns the implementation registered as id.
+func goWidgetImplementation(id C.guint) WidgetImplementation {
+	goWidgetRegistry.RLock()
+	defer goWidgetRegistry.RUnlock()
+	return goWidgetRegistry.m[int(id)]
+}
+
+// goContainerImplementation returns the container implementation
+// registered as id, or nil.
+func goContainerImplementation(id C.guint) ContainerImplementation {
+	impl, _ := goWidgetImplementation(id).(ContainerImplementation)
+	return impl
+}
+
+// containerCallback returns the function calling a GtkCallback passed to
+// the forall virtual function.
+func containerCallback(callback C.GtkCallback, data C.gpointer) func(child *Widget) {
+	return func(child *Widget) {
+		C._gotk_callback_call(callback, child.native(), data)
+	}
+}
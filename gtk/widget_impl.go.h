@@ -0,0 +1,201 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gtk/gtk.h>
+
+/*
+ * GotkWidget and GotkContainer are a GtkWidget and a GtkContainer whose size
+ * negotiation, drawing and children are provided by Go. The id is the handle
+ * of the Go implementation in goWidgetRegistry. The optional parts of the
+ * implementation report whether they are implemented, and the parent class
+ * is chained up to when they are not.
+ */
+
+extern void goWidgetGetPreferredWidth (guint id, GtkWidget *widget, gint *minimum_size, gint *natural_size);
+extern void goWidgetGetPreferredHeight (guint id, GtkWidget *widget, gint *minimum_size, gint *natural_size);
+extern gboolean goWidgetGetRequestMode (guint id, GtkWidget *widget, GtkSizeRequestMode *mode);
+extern gboolean goWidgetGetPreferredHeightForWidth (guint id, GtkWidget *widget, gint width,
+	gint *minimum_size, gint *natural_size);
+extern gboolean goWidgetGetPreferredWidthForHeight (guint id, GtkWidget *widget, gint height,
+	gint *minimum_size, gint *natural_size);
+extern void goWidgetSizeAllocate (guint id, GtkWidget *widget, GtkAllocation *allocation);
+extern gboolean goWidgetDraw (guint id, GtkWidget *widget, cairo_t *cr);
+extern void goContainerAdd (guint id, GtkContainer *container, GtkWidget *child);
+extern void goContainerRemove (guint id, GtkContainer *container, GtkWidget *child);
+extern void goContainerForall (guint id, GtkContainer *container, gboolean include_internals,
+	GtkCallback callback, gpointer callback_data);
+extern void goWidgetFinalize (guint id);
+
+static inline void _gotk_callback_call(GtkCallback callback, GtkWidget *widget, gpointer data) {
+	callback(widget, data);
+}
+
+typedef struct {
+	GtkWidget parent_instance;
+	guint id;
+} GotkWidget;
+
+typedef struct {
+	GtkWidgetClass parent_class;
+} GotkWidgetClass;
+
+G_DEFINE_TYPE (GotkWidget, gotk_widget, GTK_TYPE_WIDGET)
+
+typedef struct {
+	GtkContainer parent_instance;
+	guint id;
+} GotkContainer;
+
+typedef struct {
+	GtkContainerClass parent_class;
+} GotkContainerClass;
+
+G_DEFINE_TYPE (GotkContainer, gotk_container, GTK_TYPE_CONTAINER)
+
+#define GOTK_IS_CONTAINER(c) (G_TYPE_CHECK_INSTANCE_TYPE((c), gotk_container_get_type()))
+#define GOTK_CONTAINER_ID(c) (((GotkContainer *)(c))->id)
+
+/*
+ * The size negotiation and drawing are shared by both types.
+ */
+
+static guint _gotk_widget_id (gpointer widget) {
+	if (GOTK_IS_CONTAINER(widget))
+		return GOTK_CONTAINER_ID(widget);
+	return ((GotkWidget *)(widget))->id;
+}
+
+static GtkWidgetClass *_gotk_widget_parent_class (gpointer widget) {
+	if (GOTK_IS_CONTAINER(widget))
+		return GTK_WIDGET_CLASS(gotk_container_parent_class);
+	return GTK_WIDGET_CLASS(gotk_widget_parent_class);
+}
+
+static void _gotk_widget_get_preferred_width (GtkWidget *widget, gint *minimum_size, gint *natural_size) {
+	goWidgetGetPreferredWidth(_gotk_widget_id(widget), widget, minimum_size, natural_size);
+}
+
+static void _gotk_widget_get_preferred_height (GtkWidget *widget, gint *minimum_size, gint *natural_size) {
+	goWidgetGetPreferredHeight(_gotk_widget_id(widget), widget, minimum_size, natural_size);
+}
+
+static GtkSizeRequestMode _gotk_widget_get_request_mode (GtkWidget *widget) {
+	GtkSizeRequestMode mode;
+
+	if (goWidgetGetRequestMode(_gotk_widget_id(widget), widget, &mode))
+		return mode;
+	return _gotk_widget_parent_class(widget)->get_request_mode(widget);
+}
+
+static void _gotk_widget_get_preferred_height_for_width (GtkWidget *widget, gint width,
+	gint *minimum_size, gint *natural_size) {
+	if (!goWidgetGetPreferredHeightForWidth(_gotk_widget_id(widget), widget, width, minimum_size, natural_size))
+		_gotk_widget_parent_class(widget)->get_preferred_height_for_width(widget, width,
+			minimum_size, natural_size);
+}
+
+static void _gotk_widget_get_preferred_width_for_height (GtkWidget *widget, gint height,
+	gint *minimum_size, gint *natural_size) {
+	if (!goWidgetGetPreferredWidthForHeight(_gotk_widget_id(widget), widget, height, minimum_size, natural_size))
+		_gotk_widget_parent_class(widget)->get_preferred_width_for_height(widget, height,
+			minimum_size, natural_size);
+}
+
+static void _gotk_widget_size_allocate (GtkWidget *widget, GtkAllocation *allocation) {
+	_gotk_widget_parent_class(widget)->size_allocate(widget, allocation);
+	goWidgetSizeAllocate(_gotk_widget_id(widget), widget, allocation);
+}
+
+static gboolean _gotk_widget_draw (GtkWidget *widget, cairo_t *cr) {
+	GtkWidgetClass *parent_class = _gotk_widget_parent_class(widget);
+
+	if (goWidgetDraw(_gotk_widget_id(widget), widget, cr))
+		return TRUE;
+	if (parent_class->draw)
+		return parent_class->draw(widget, cr);
+	return FALSE;
+}
+
+static void _gotk_widget_finalize (GObject *object) {
+	GObjectClass *parent_class = G_OBJECT_CLASS(_gotk_widget_parent_class(object));
+
+	goWidgetFinalize(_gotk_widget_id(object));
+	parent_class->finalize(object);
+}
+
+static void _gotk_widget_class_init_vfuncs (GtkWidgetClass *widget_class) {
+	G_OBJECT_CLASS(widget_class)->finalize = _gotk_widget_finalize;
+	widget_class->get_preferred_width = _gotk_widget_get_preferred_width;
+	widget_class->get_preferred_height = _gotk_widget_get_preferred_height;
+	widget_class->get_request_mode = _gotk_widget_get_request_mode;
+	widget_class->get_preferred_height_for_width = _gotk_widget_get_preferred_height_for_width;
+	widget_class->get_preferred_width_for_height = _gotk_widget_get_preferred_width_for_height;
+	widget_class->size_allocate = _gotk_widget_size_allocate;
+	widget_class->draw = _gotk_widget_draw;
+}
+
+/*
+ * GotkWidget
+ */
+
+static void gotk_widget_class_init (GotkWidgetClass *klass) {
+	_gotk_widget_class_init_vfuncs(GTK_WIDGET_CLASS(klass));
+}
+
+static void gotk_widget_init (GotkWidget *self) {
+	gtk_widget_set_has_window(GTK_WIDGET(self), FALSE);
+}
+
+static inline GtkWidget *_gotk_go_widget_new (guint id) {
+	GotkWidget *widget = g_object_new(gotk_widget_get_type(), NULL);
+	widget->id = id;
+	return GTK_WIDGET(widget);
+}
+
+/*
+ * GotkContainer. The children are parented and unparented here, the Go
+ * implementation only keeping track of them.
+ */
+
+static void gotk_container_add (GtkContainer *container, GtkWidget *child) {
+	goContainerAdd(GOTK_CONTAINER_ID(container), container, child);
+	gtk_widget_set_parent(child, GTK_WIDGET(container));
+}
+
+static void gotk_container_remove (GtkContainer *container, GtkWidget *child) {
+	gboolean was_visible = gtk_widget_get_visible(child);
+
+	/* The reference of the container may be the last one of the child,
+	 * which is dropped by gtk_widget_unparent(). */
+	g_object_ref(child);
+	goContainerRemove(GOTK_CONTAINER_ID(container), container, child);
+	gtk_widget_unparent(child);
+	g_object_unref(child);
+	if (was_visible)
+		gtk_widget_queue_resize(GTK_WIDGET(container));
+}
+
+static void gotk_container_forall (GtkContainer *container, gboolean include_internals,
+	GtkCallback callback, gpointer callback_data) {
+	goContainerForall(GOTK_CONTAINER_ID(container), container, include_internals, callback, callback_data);
+}
+
+static void gotk_container_class_init (GotkContainerClass *klass) {
+	GtkContainerClass *container_class = GTK_CONTAINER_CLASS(klass);
+
+	_gotk_widget_class_init_vfuncs(GTK_WIDGET_CLASS(klass));
+	container_class->add = gotk_container_add;
+	container_class->remove = gotk_container_remove;
+	container_class->forall = gotk_container_forall;
+}
+
+static void gotk_container_init (GotkContainer *self) {
+	gtk_widget_set_has_window(GTK_WIDGET(self), FALSE);
+}
+
+static inline GtkWidget *_gotk_go_container_new (guint id) {
+	GotkContainer *container = g_object_new(gotk_container_get_type(), NULL);
+	container->id = id;
+	return GTK_WIDGET(container);
+}
@@ -0,0 +1,230 @@
+// Same copyright and license as the rest of the files in this project
+
+package gtk_test
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// tile is a widget of a fixed size filled with a color.
+type tile struct {
+	width, height int
+	allocation    *gtk.Allocation
+	drawn         bool
+}
+
+func (t *tile) GetPreferredWidth(widget *gtk.Widget) (int, int) {
+	return t.width, t.width
+}
+
+func (t *tile) GetPreferredHeight(widget *gtk.Widget) (int, int) {
+	return t.height, t.height
+}
+
+func (t *tile) SizeAllocate(widget *gtk.Widget, allocation *gtk.Allocation) {
+	t.allocation = allocation
+}
+
+func (t *tile) Draw(widget *gtk.Widget, cr *cairo.Context) bool {
+	cr.SetSourceRGB(0, 0, 1)
+	cr.Rectangle(0, 0, float64(widget.GetAllocatedWidth()), float64(widget.GetAllocatedHeight()))
+	cr.Fill()
+	t.drawn = true
+	return false
+}
+
+// masonry lays its children out in columns of equal width, each child
+// going below the shortest column.
+type masonry struct {
+	columns  int
+	children []*gtk.Widget
+	// parented tells whether the last child removed was still parented.
+	parented bool
+}
+
+func (m *masonry) Add(container *gtk.Container, child *gtk.Widget) {
+	m.children = append(m.children, child)
+}
+
+func (m *masonry) Remove(container *gtk.Container, child *gtk.Widget) {
+	parent, _ := child.GetParent()
+	m.parented = parent != nil
+	for i, c := range m.children {
+		if c.Native() == child.Native() {
+			m.children = append(m.children[:i], m.children[i+1:]...)
+			return
+		}
+	}
+}
+
+func (m *masonry) Forall(container *gtk.Container, includeInternals bool, callback func(child *gtk.Widget)) {
+	for _, child := range append([]*gtk.Widget(nil), m.children...) {
+		callback(child)
+	}
+}
+
+func (m *masonry) GetRequestMode(widget *gtk.Widget) gtk.SizeRequestMode {
+	return gtk.SIZE_REQUEST_HEIGHT_FOR_WIDTH
+}
+
+func (m *masonry) GetPreferredWidth(widget *gtk.Widget) (int, int) {
+	var min, nat int
+	for _, child := range m.children {
+		cmin, cnat := child.GetPreferredWidth()
+		if cmin > min {
+			min = cmin
+		}
+		if cnat > nat {
+			nat = cnat
+		}
+	}
+	return min * m.columns, nat * m.columns
+}
+
+func (m *masonry) GetPreferredHeight(widget *gtk.Widget) (int, int) {
+	_, nat := m.GetPreferredWidth(widget)
+	return m.GetPreferredHeightForWidth(widget, nat)
+}
+
+func (m *masonry) GetPreferredHeightForWidth(widget *gtk.Widget, width int) (int, int) {
+	height := 0
+	for _, y := range m.layout(width, nil) {
+		if y > height {
+			height = y
+		}
+	}
+	return height, height
+}
+
+func (m *masonry) GetPreferredWidthForHeight(widget *gtk.Widget, height int) (int, int) {
+	return m.GetPreferredWidth(widget)
+}
+
+func (m *masonry) SizeAllocate(widget *gtk.Widget, allocation *gtk.Allocation) {
+	m.layout(allocation.GetWidth(), func(child *gtk.Widget, x, y, width, height int) {
+		var a gtk.Allocation
+		a.SetX(allocation.GetX() + x)
+		a.SetY(allocation.GetY() + y)
+		a.SetWidth(width)
+		a.SetHeight(height)
+		child.SizeAllocate(&a)
+	})
+}
+
+// layout places the children in width, and returns the height of each
+// column.
+func (m *masonry) layout(width int, place func(child *gtk.Widget, x, y, width, height int)) []int {
+	columnWidth := width / m.columns
+	heights := make([]int, m.columns)
+	for _, child := range m.children {
+		if !child.GetVisible() {
+			continue
+		}
+		column := 0
+		for i, h := range heights {
+			if h < heights[column] {
+				column = i
+			}
+		}
+		_, height := child.GetPreferredHeightForWidth(columnWidth)
+		if place != nil {
+			place(child, column*columnWidth, heights[column], columnWidth, height)
+		}
+		heights[column] += height
+	}
+	return heights
+}
+
+func TestGoContainerMasonry(t *testing.T) {
+	impl := &masonry{columns: 2}
+	container, err := gtk.GoContainerNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tiles := []*tile{{width: 50, height: 10}, {width: 50, height: 30}, {width: 50, height: 25}}
+	for _, tile := range tiles {
+		w, err := gtk.GoWidgetNew(tile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Show()
+		container.Add(w)
+	}
+	if n := container.GetChildren().Length(); n != 3 {
+		t.Fatalf("expected 3 children, got %d", n)
+	}
+	if container.GetRequestMode() != gtk.SIZE_REQUEST_HEIGHT_FOR_WIDTH {
+		t.Error("expected the container to trade height for width")
+	}
+	if min, nat := container.GetPreferredWidth(); min != 100 || nat != 100 {
+		t.Errorf("expected a preferred width of (100, 100), got (%d, %d)", min, nat)
+	}
+	if min, _ := container.GetPreferredHeightForWidth(100); min != 35 {
+		t.Errorf("expected a height of 35 for a width of 100, got %d", min)
+	}
+
+	renderOffscreen(t, container, func() bool {
+		for _, tile := range tiles {
+			if !tile.drawn {
+				return false
+			}
+		}
+		return true
+	})
+
+	if tiles[0].allocation == nil {
+		t.Fatal("expected the container to allocate its children")
+	}
+	want := [][2]int{{0, 0}, {50, 0}, {0, 10}}
+	for i, tile := range tiles {
+		if !tile.drawn {
+			t.Errorf("expected tile %d to be drawn", i)
+		}
+		if tile.allocation == nil {
+			t.Errorf("expected tile %d to be allocated", i)
+			continue
+		}
+		x := tile.allocation.GetX() - tiles[0].allocation.GetX()
+		y := tile.allocation.GetY() - tiles[0].allocation.GetY()
+		if x != want[i][0] || y != want[i][1] || tile.allocation.GetWidth() != 50 {
+			t.Errorf("expected tile %d at %v with a width of 50, got (%d, %d) with a width of %d",
+				i, want[i], x, y, tile.allocation.GetWidth())
+		}
+	}
+
+	// Destroying the offscreen window removed the children.
+	if len(impl.children) != 0 {
+		t.Errorf("expected the children to be removed, %d left", len(impl.children))
+	}
+}
+
+func TestGoContainerRemove(t *testing.T) {
+	impl := &masonry{columns: 1}
+	container, err := gtk.GoContainerNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := gtk.GoWidgetNew(&tile{width: 10, height: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	container.Add(w)
+	if parent, _ := w.GetParent(); parent == nil || parent.ToWidget().Native() != container.Native() {
+		t.Fatal("expected the child to be parented to the container")
+	}
+
+	container.Remove(w)
+	if len(impl.children) != 0 {
+		t.Error("expected the child to be removed from the implementation")
+	}
+	if !impl.parented {
+		t.Error("expected the child to be parented while it is removed")
+	}
+	if parent, _ := w.GetParent(); parent != nil {
+		t.Error("expected the child to be unparented")
+	}
+}
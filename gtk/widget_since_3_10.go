@@ -3,20 +3,296 @@
 package gtk
 
 // #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "widget_since_3_10.go.h"
 import "C"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
 
 // TODO:
 // gtk_widget_get_preferred_height_and_baseline_for_width().
 // gtk_widget_get_valign_with_baseline().
-// gtk_widget_init_template().
-// gtk_widget_class_set_template().
-// gtk_widget_class_set_template_from_resource().
-// gtk_widget_get_template_child().
-// gtk_widget_class_bind_template_child().
 // gtk_widget_class_bind_template_child_internal().
 // gtk_widget_class_bind_template_child_private().
 // gtk_widget_class_bind_template_child_internal_private().
-// gtk_widget_class_bind_template_child_full().
-// gtk_widget_class_bind_template_callback().
-// gtk_widget_class_bind_template_callback_full().
-// gtk_widget_class_set_connect_func().
+
+/*
+ * Widget types defined in Go
+ */
+
+// WidgetClass is a representation of GTK's GtkWidgetClass. It is handed to
+// the WidgetClassInitFunc of a widget type registered with
+// RegisterWidgetType, and is only valid during that call.
+type WidgetClass struct {
+	native *C.GtkWidgetClass
+	id     int
+	data   *widgetClassData
+}
+
+// WidgetClassInitFunc is called once the class of a widget type registered
+// with RegisterWidgetType is initialized, before its first instance is
+// created. It typically sets the template of the class and binds its
+// children and callbacks.
+type WidgetClassInitFunc func(class *WidgetClass)
+
+type widgetClassData struct {
+	init      WidgetClassInitFunc
+	callbacks map[string]interface{}
+}
+
+var (
+	widgetClassRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]*widgetClassData
+	}{
+		next: 1,
+		m:    make(map[int]*widgetClassData),
+	}
+
+	// templateInit collects the callbacks missing while a template is
+	// initialized by Widget.InitTemplate or WidgetNewWithType.
+	templateInit = struct {
+		sync.Mutex
+		collecting bool
+		missing    []string
+	}{}
+
+	emptyInterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// RegisterWidgetType registers a new widget type called name, derived from
+// the widget type parent, such as glib.TypeFromName("GtkBox"). Instances of
+// the new type, created with WidgetNewWithType, are wrapped as instances of
+// their closest ancestor known to this package, such as *Box. If the class
+// has a template, each instance is built from it when it is created.
+func RegisterWidgetType(name string, parent glib.Type, classInit WidgetClassInitFunc) (glib.Type, error) {
+	if !gobool(C.g_type_is_a(C.GType(parent), C.gtk_widget_get_type())) {
+		return glib.TYPE_INVALID, errors.New(parent.Name() + " is not a widget type")
+	}
+
+	widgetClassRegistry.Lock()
+	id := widgetClassRegistry.next
+	widgetClassRegistry.next++
+	widgetClassRegistry.m[id] = &widgetClassData{
+		init:      classInit,
+		callbacks: make(map[string]interface{}),
+	}
+	widgetClassRegistry.Unlock()
+
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	t := glib.Type(C._gotk_widget_type_register(C.GType(parent), (*C.gchar)(cstr), C.guint(id)))
+	if t == glib.TYPE_INVALID {
+		widgetClassRegistry.Lock()
+		delete(widgetClassRegistry.m, id)
+		widgetClassRegistry.Unlock()
+		return glib.TYPE_INVALID, errors.New("unable to register widget type " + name)
+	}
+
+	// Wrap instances as the closest wrapped ancestor, GtkWidget at worst.
+	wrapMapLock.Lock()
+	defer wrapMapLock.Unlock()
+	for p := parent; p != glib.TYPE_INVALID; p = p.Parent() {
+		if fn, ok := WrapMap[p.Name()]; ok {
+			WrapMap[name] = fn
+			break
+		}
+	}
+	return t, nil
+}
+
+// WidgetNewWithType creates a new instance of the widget type t, such as a
+// type registered with RegisterWidgetType. The instance is returned along
+// with a *MissingHandlersError if signals of its template use callbacks not
+// bound to the class, which are left unconnected.
+func WidgetNewWithType(t glib.Type) (IWidget, error) {
+	if !gobool(C.g_type_is_a(C.GType(t), C.gtk_widget_get_type())) {
+		return nil, errors.New(t.Name() + " is not a widget type")
+	}
+
+	templateInit.Lock()
+	templateInit.collecting, templateInit.missing = true, nil
+	c := C._gotk_widget_new(C.GType(t))
+	missing := templateInit.missing
+	templateInit.collecting, templateInit.missing = false, nil
+	templateInit.Unlock()
+
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	w, err := castWidget(c)
+	if err == nil && len(missing) > 0 {
+		err = &MissingHandlersError{missing}
+	}
+	return w, err
+}
+
+// SetTemplate is a wrapper around gtk_widget_class_set_template(). It sets
+// the UI definition each instance is built from by Widget.InitTemplate.
+func (v *WidgetClass) SetTemplate(xml string) {
+	cstr := C.CString(xml)
+	defer C.free(unsafe.Pointer(cstr))
+	C._gtk_widget_class_set_template(v.native, (*C.gchar)(cstr), C.gsize(len(xml)))
+	v.setConnectFunc()
+}
+
+// SetTemplateFromResource is a wrapper around
+// gtk_widget_class_set_template_from_resource().
+func (v *WidgetClass) SetTemplateFromResource(resourceName string) {
+	cstr := C.CString(resourceName)
+	defer C.free(unsafe.Pointer(cstr))
+	C._gtk_widget_class_set_template_from_resource(v.native, (*C.gchar)(cstr))
+	v.setConnectFunc()
+}
+
+// setConnectFunc connects the signals of the template to the callbacks
+// bound with BindTemplateCallback, reporting the missing ones, rather than
+// to symbols looked up by GtkBuilder. GTK requires the template to be set
+// first.
+func (v *WidgetClass) setConnectFunc() {
+	C._gtk_widget_class_set_connect_func(v.native, C.guint(v.id))
+}
+
+// BindTemplateChild is a wrapper around
+// gtk_widget_class_bind_template_child_full(). It makes the object with the
+// id name in the template available to Widget.GetTemplateChild.
+func (v *WidgetClass) BindTemplateChild(name string) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_widget_class_bind_template_child_full(v.native, (*C.gchar)(cstr), gbool(false), 0)
+}
+
+// BindTemplateChildren binds the id of each exported field of the struct
+// prototype tagged with `gtk:"id"`, as Builder.Bind uses them. See
+// Widget.GetTemplateChildren.
+func (v *WidgetClass) BindTemplateChildren(prototype interface{}) {
+	t := reflect.TypeOf(prototype)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if id := fieldID(t.Field(i)); id != "" {
+			v.BindTemplateChild(id)
+		}
+	}
+}
+
+// BindTemplateCallback connects the signals of the template using the
+// handler name to f, with the same conventions as Builder.ConnectSignals.
+// It is the counterpart of gtk_widget_class_bind_template_callback_full(),
+// implemented with gtk_widget_class_set_connect_func().
+//
+// As for C callbacks, the object of a signal element is passed to f after
+// the arguments of the signal, or in place of the object emitting the
+// signal with swapped="yes", that object then being passed last.
+func (v *WidgetClass) BindTemplateCallback(name string, f interface{}) {
+	widgetClassRegistry.Lock()
+	v.data.callbacks[name] = f
+	widgetClassRegistry.Unlock()
+}
+
+// InitTemplate is a wrapper around gtk_widget_init_template(). It builds the
+// template of the widget class into v, and must be called once on each new
+// instance of a class with a template, except for the types registered with
+// RegisterWidgetType, whose instances are built from their template when
+// they are created. It returns a *MissingHandlersError if signals of the
+// template use callbacks not bound to the class.
+func (v *Widget) InitTemplate() error {
+	templateInit.Lock()
+	defer templateInit.Unlock()
+
+	templateInit.collecting, templateInit.missing = true, nil
+	C.gtk_widget_init_template(v.native())
+	missing := templateInit.missing
+	templateInit.collecting, templateInit.missing = false, nil
+	if len(missing) > 0 {
+		return &MissingHandlersError{missing}
+	}
+	return nil
+}
+
+// signalNParams returns the number of parameters of the signal of object,
+// not counting object itself, or -1 if it has no such signal.
+func signalNParams(object *C.GObject, signal *C.gchar) int {
+	return int(C._gotk_signal_n_params(object, signal))
+}
+
+// swappedHandler returns a function calling handler with data in place of
+// the object emitting a signal with nParams parameters, not counting that
+// object, which is passed last instead if handler takes all of them, as
+// for a C callback connected with G_CONNECT_SWAPPED.
+func swappedHandler(handler, data interface{}, nParams int) interface{} {
+	rf := reflect.ValueOf(handler)
+	t := rf.Type()
+	if nParams < 0 || t.Kind() != reflect.Func || t.NumIn() == 0 || t.NumIn() > nParams+2 {
+		// Let the connection report the mismatch.
+		return handler
+	}
+
+	// The function is passed the emitting object and the parameters of the
+	// signal, as many as handler takes besides data.
+	last := t.NumIn() == nParams+2
+	in := []reflect.Type{emptyInterfaceType}
+	if last {
+		in[0] = t.In(nParams + 1)
+	}
+	for i := 1; i < t.NumIn() && i <= nParams; i++ {
+		in = append(in, t.In(i))
+	}
+	out := make([]reflect.Type, t.NumOut())
+	for i := range out {
+		out[i] = t.Out(i)
+	}
+
+	ft := reflect.FuncOf(in, out, false)
+	return reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+		call := make([]reflect.Value, t.NumIn())
+		call[0] = reflect.ValueOf(data).Convert(t.In(0))
+		copy(call[1:], args[1:])
+		if last {
+			call[nParams+1] = args[0]
+		}
+		return rf.Call(call)
+	}).Interface()
+}
+
+// GetTemplateChild is a wrapper around gtk_widget_get_template_child(). It
+// returns the object with the id name in the template of widgetType, which
+// must have been bound with WidgetClass.BindTemplateChild.
+func (v *Widget) GetTemplateChild(widgetType glib.Type, name string) (glib.IObject, error) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gtk_widget_get_template_child(v.native(), C.GType(widgetType), (*C.gchar)(cstr))
+	if c == nil {
+		return nil, fmt.Errorf("no template child '%s' in %s", name, widgetType.Name())
+	}
+	return cast(c)
+}
+
+// GetTemplateChildren sets the fields of the struct pointed to by target,
+// tagged as for WidgetClass.BindTemplateChildren, to the children of the
+// template of the type of v. It returns a *BindError listing the fields
+// which could not be set.
+func (v *Widget) GetTemplateChildren(target interface{}) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("GetTemplateChildren expects a pointer to a struct, got %T", target)
+	}
+
+	t := v.TypeFromInstance()
+	errs := bindFields(rv.Elem(), func(id string) (glib.IObject, error) {
+		return v.GetTemplateChild(t, id)
+	})
+	if len(errs) > 0 {
+		return &BindError{errs}
+	}
+	return nil
+}
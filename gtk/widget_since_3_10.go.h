@@ -0,0 +1,90 @@
+// Same copyright and license as the rest of the files in this project
+
+#include <stdlib.h>
+
+#include <gtk/gtk.h>
+
+/*
+ * Widget types registered from Go. The class data is the id of the class
+ * in widgetClassRegistry, whose initialization function is called once
+ * GObject initializes the class.
+ */
+
+extern void goWidgetClassInit (GtkWidgetClass *klass, guint id);
+extern void goWidgetTemplateConnect (GtkBuilder *builder,
+                                     GObject *object,
+                                     gchar *signal_name,
+                                     gchar *handler_name,
+                                     GObject *connect_object,
+                                     GConnectFlags flags,
+                                     gpointer user_data);
+
+static void _gotk_widget_class_init(gpointer klass, gpointer class_data) {
+	goWidgetClassInit(GTK_WIDGET_CLASS(klass), GPOINTER_TO_UINT(class_data));
+}
+
+/* The types whose class has a template are marked with this quark. */
+static GQuark _gotk_widget_template_quark (void) {
+	return g_quark_from_static_string("gotk-widget-template");
+}
+
+static void _gotk_widget_instance_init(GTypeInstance *instance, gpointer g_class) {
+	GType type = G_TYPE_FROM_CLASS(g_class);
+
+	/* The instance has the class of the type being initialized, which is
+	 * only the one of g_class for the type being instantiated, so the
+	 * template is built once even if a registered type derives from
+	 * another one. */
+	if (G_TYPE_FROM_INSTANCE(instance) == type &&
+	    g_type_get_qdata(type, _gotk_widget_template_quark()) != NULL)
+		gtk_widget_init_template(GTK_WIDGET(instance));
+}
+
+static GType _gotk_widget_type_register(GType parent, const gchar *name, guint id) {
+	GTypeQuery query;
+	GTypeInfo info = { 0 };
+
+	g_type_query(parent, &query);
+	if (query.type == 0)
+		return G_TYPE_INVALID;
+
+	info.class_size = query.class_size;
+	info.instance_size = query.instance_size;
+	info.class_init = _gotk_widget_class_init;
+	info.class_data = GUINT_TO_POINTER(id);
+	info.instance_init = _gotk_widget_instance_init;
+	return g_type_register_static(parent, name, &info, 0);
+}
+
+static inline GtkWidget *_gotk_widget_new(GType type) {
+	return GTK_WIDGET(g_object_new(type, NULL));
+}
+
+static inline void _gtk_widget_class_set_template(GtkWidgetClass *klass, const gchar *data, gsize length) {
+	GBytes *bytes = g_bytes_new(data, length);
+	gtk_widget_class_set_template(klass, bytes);
+	g_bytes_unref(bytes);
+	g_type_set_qdata(G_TYPE_FROM_CLASS(klass), _gotk_widget_template_quark(), GINT_TO_POINTER(TRUE));
+}
+
+static inline void _gtk_widget_class_set_template_from_resource(GtkWidgetClass *klass, const gchar *resource_name) {
+	gtk_widget_class_set_template_from_resource(klass, resource_name);
+	g_type_set_qdata(G_TYPE_FROM_CLASS(klass), _gotk_widget_template_quark(), GINT_TO_POINTER(TRUE));
+}
+
+static inline void _gtk_widget_class_set_connect_func(GtkWidgetClass *klass, guint id) {
+	gtk_widget_class_set_connect_func(klass, (GtkBuilderConnectFunc)(goWidgetTemplateConnect),
+		GUINT_TO_POINTER(id), NULL);
+}
+
+/* Returns the number of parameters of the signal of object, not counting
+ * the object itself, or -1 if there is no such signal. */
+static inline gint _gotk_signal_n_params(GObject *object, const gchar *detailed_signal) {
+	guint id;
+	GSignalQuery query;
+
+	if (!g_signal_parse_name(detailed_signal, G_OBJECT_TYPE(object), &id, NULL, FALSE))
+		return -1;
+	g_signal_query(id, &query);
+	return query.n_params;
+}
@@ -0,0 +1,144 @@
+// Same copyright and license as the rest of the files in this project
+
+// +build !gtk_3_6,!gtk_3_8
+
+package gtk
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+const greeterTemplate = `<interface>
+  <template class="GotkTestGreeter" parent="GtkBox">
+    <property name="orientation">vertical</property>
+    <child>
+      <object class="GtkEntry" id="name_entry"/>
+    </child>
+    <child>
+      <object class="GtkButton" id="greet_button">
+        <property name="label">Greet</property>
+        <signal name="clicked" handler="on_greet_after" after="yes"/>
+        <signal name="clicked" handler="on_greet_clicked"/>
+        <signal name="clicked" handler="on_greet_entry" object="name_entry"/>
+        <signal name="clicked" handler="on_greet_swapped" object="name_entry" swapped="yes"/>
+      </object>
+    </child>
+  </template>
+</interface>`
+
+type greeter struct {
+	*Box
+	NameEntry   *Entry  `gtk:"name_entry"`
+	GreetButton *Button `gtk:"greet_button"`
+}
+
+func TestWidgetTemplate(t *testing.T) {
+	// Make sure the parent type is registered before looking it up.
+	if _, err := BoxNew(ORIENTATION_VERTICAL, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var calls []string
+	var entries []*Entry
+	typ, err := RegisterWidgetType("GotkTestGreeter", glib.TypeFromName("GtkBox"), func(class *WidgetClass) {
+		class.SetTemplate(greeterTemplate)
+		class.BindTemplateChildren(greeter{})
+		class.BindTemplateCallback("on_greet_after", func() {
+			calls = append(calls, "after")
+		})
+		class.BindTemplateCallback("on_greet_clicked", func() {
+			calls = append(calls, "clicked")
+		})
+		class.BindTemplateCallback("on_greet_entry", func(button *Button, entry *Entry) {
+			calls = append(calls, "entry")
+			entries = append(entries, entry)
+		})
+		class.BindTemplateCallback("on_greet_swapped", func(entry *Entry, button *Button) {
+			calls = append(calls, "swapped")
+			entries = append(entries, entry)
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := WidgetNewWithType(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	box, ok := w.(*Box)
+	if !ok {
+		t.Fatalf("expected *Box, got %T", w)
+	}
+
+	g := greeter{Box: box}
+	if err := box.GetTemplateChildren(&g); err != nil {
+		t.Fatal(err)
+	}
+	if label, _ := g.GreetButton.GetLabel(); label != "Greet" {
+		t.Errorf("expected the templated button, got label %q", label)
+	}
+	if box.GetOrientation() != ORIENTATION_VERTICAL {
+		t.Error("expected the template to set the orientation")
+	}
+
+	g.GreetButton.Clicked()
+	if len(calls) != 4 || calls[3] != "after" {
+		t.Errorf("expected the 4 callbacks to be called, the one connected after last, got %v", calls)
+	}
+	if len(entries) != 2 {
+		t.Errorf("expected the entry to be passed to 2 callbacks, got %d", len(entries))
+	}
+	for _, entry := range entries {
+		if entry.Widget.Native() != g.NameEntry.Widget.Native() {
+			t.Error("expected the entry of the signal element to be passed to the callback")
+		}
+	}
+
+	if _, err := box.GetTemplateChild(typ, "missing"); err == nil {
+		t.Error("expected an error for an unbound child")
+	}
+	if _, err := RegisterWidgetType("GotkTestNotAWidget", glib.TYPE_OBJECT, nil); err == nil {
+		t.Error("expected an error for a parent which is not a widget")
+	}
+}
+
+func TestWidgetTemplateMissingHandlers(t *testing.T) {
+	if _, err := BoxNew(ORIENTATION_VERTICAL, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	const ui = `<interface>
+  <template class="GotkTestUnbound" parent="GtkBox">
+    <child>
+      <object class="GtkButton">
+        <signal name="clicked" handler="on_unbound_clicked"/>
+      </object>
+    </child>
+  </template>
+</interface>`
+	typ, err := RegisterWidgetType("GotkTestUnbound", glib.TypeFromName("GtkBox"), func(class *WidgetClass) {
+		class.SetTemplate(ui)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := WidgetNewWithType(typ)
+	missing, ok := err.(*MissingHandlersError)
+	if !ok {
+		t.Fatalf("expected *MissingHandlersError, got %v", err)
+	}
+	if len(missing.Handlers) != 1 || missing.Handlers[0] != "on_unbound_clicked" {
+		t.Errorf("expected on_unbound_clicked to be missing, got %v", missing.Handlers)
+	}
+	box, ok := w.(*Box)
+	if !ok {
+		t.Fatalf("expected *Box, got %T", w)
+	}
+	if n := box.GetChildren().Length(); n != 1 {
+		t.Errorf("expected the template to be built once, got %d children", n)
+	}
+}